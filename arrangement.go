@@ -0,0 +1,108 @@
+package chinesecalendar
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Arrangement 一天的放假安排
+type Arrangement struct {
+	// Date 日期，只使用其年月日
+	Date time.Time
+	// Holiday 所属的节日，调休上班日为其补偿的节日
+	Holiday Holiday
+	// Workday 为 true 表示调休上班，否则表示放假
+	Workday bool
+	// Statutory 是否法定假日，只能用于放假的日期
+	Statutory bool
+	// InLieu 是否替代日，只能用于放假的日期
+	InLieu bool
+	// HalfDay 为 true 表示当天上半天班、另外半天因 Holiday 放假（例如公司 12 月 24 日下午放假），
+	// 只能用于上班的日期，当天仍为工作日，见 Calendar.AttendanceFor
+	HalfDay bool
+}
+
+// NewCalendar 用放假安排构建日历，start、end 为数据覆盖的日期范围（包括起止日期），
+// 范围内没有安排的日期按周一至周五上班、周六周日休息处理
+func NewCalendar(start, end time.Time, arrangements []Arrangement) (*Calendar, error) {
+	startDay, endDay := civilDateOf(start), civilDateOf(end)
+	if endDay.before(startDay) {
+		return nil, fmt.Errorf("%w: end %s is before start %s", ErrInvalidArrangement, endDay, startDay)
+	}
+
+	days := make([]civilDate, 0, len(arrangements))
+	byDay := make(map[civilDate]Arrangement, len(arrangements))
+	for _, a := range arrangements {
+		d := civilDateOf(a.Date)
+		switch {
+		case d.before(startDay) || d.after(endDay):
+			return nil, fmt.Errorf("%w: %s is out of range %s - %s", ErrInvalidArrangement, d, startDay, endDay)
+		case a.Holiday.Name() == "":
+			return nil, fmt.Errorf("%w: %s has no holiday", ErrInvalidArrangement, d)
+		case a.Workday && (a.Statutory || a.InLieu):
+			return nil, fmt.Errorf("%w: workday %s cannot be statutory or in lieu", ErrInvalidArrangement, d)
+		case a.HalfDay && !a.Workday:
+			return nil, fmt.Errorf("%w: half day %s must be a workday", ErrInvalidArrangement, d)
+		}
+		if _, ok := byDay[d]; ok {
+			return nil, fmt.Errorf("%w: %s is duplicated", ErrInvalidArrangement, d)
+		}
+		byDay[d] = a
+		days = append(days, d)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].before(days[j]) })
+
+	c := &Calendar{minDay: startDay, maxDay: endDay, groups: groupHolidays}
+	for year := startDay.year; year <= endDay.year; year++ {
+		c.tables = append(c.tables, yearTable{year: year})
+	}
+	for _, d := range days {
+		a := byDay[d]
+		tb, i := c.tableOf(d)
+		switch {
+		case !a.Workday:
+			tb.holidays.set(i)
+		case a.HalfDay && !tb.weekends().has(i):
+			// 周一至周五本来就上班，不是调休上班日
+		default:
+			tb.workdays.set(i)
+		}
+		if a.HalfDay {
+			tb.halfDays.set(i)
+		}
+		if a.Statutory {
+			tb.statutory.set(i)
+		}
+		if a.InLieu {
+			tb.inLieuDays.set(i)
+		}
+		if n := len(tb.tags); n > 0 && tb.tags[n-1].last == i-1 && tb.tags[n-1].holiday == a.Holiday {
+			tb.tags[n-1].last = i
+		} else {
+			tb.tags = append(tb.tags, dayTag{i, i, a.Holiday})
+		}
+	}
+	return c, nil
+}
+
+// Arrangements 返回日历中的全部放假安排，按日期排列，日期为 ChinaStandardTime 时区的零点
+func (c *Calendar) Arrangements() []Arrangement {
+	list := make([]Arrangement, 0)
+	for k := range c.tables {
+		tb := &c.tables[k]
+		for _, tag := range tb.tags {
+			for i := tag.first; i <= tag.last; i++ {
+				list = append(list, Arrangement{
+					Date:      dateOfYearDay(tb.year, i).time(ChinaStandardTime),
+					Holiday:   tag.holiday,
+					Workday:   tb.workdays.has(i) || tb.halfDays.has(i),
+					Statutory: tb.statutory.has(i),
+					InLieu:    tb.inLieuDays.has(i),
+					HalfDay:   tb.halfDays.has(i),
+				})
+			}
+		}
+	}
+	return list
+}
@@ -0,0 +1,68 @@
+package chinesecalendar
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCalendarFromDefault(t *testing.T) {
+	c, err := NewCalendar(minDay.time(time.UTC), maxDay.time(time.UTC), Default().Arrangements())
+	assert.NoError(t, err)
+	assert.Equal(t, defaultCalendar.tables, c.tables)
+	assert.Equal(t, defaultCalendar.minDay, c.minDay)
+	assert.Equal(t, defaultCalendar.maxDay, c.maxDay)
+}
+
+func TestNewCalendar(t *testing.T) {
+	anniversary := NewHoliday("Anniversary", "司庆", 0)
+	c, err := NewCalendar(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2030, 12, 31, 0, 0, 0, 0, time.UTC), []Arrangement{
+		{Date: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), Holiday: NewYearsDay, Statutory: true},
+		{Date: time.Date(2030, 6, 6, 0, 0, 0, 0, time.UTC), Holiday: anniversary, InLieu: true},
+		{Date: time.Date(2030, 6, 8, 0, 0, 0, 0, time.UTC), Holiday: anniversary, Workday: true},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, true, c.IsHoliday(time.Date(2030, 1, 1, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.Equal(t, true, c.IsHoliday(time.Date(2030, 6, 6, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.Equal(t, true, c.IsInLieu(time.Date(2030, 6, 6, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.Equal(t, true, c.IsWorkday(time.Date(2030, 6, 8, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.Equal(t, true, c.IsWorkday(time.Date(2030, 6, 7, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.Equal(t, false, IsWorkday(time.Date(2030, 6, 7, 0, 0, 0, 0, ChinaStandardTime)))
+
+	holiday, isHoliday := c.GetHolidayDetail(time.Date(2030, 6, 6, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, true, isHoliday)
+	assert.Equal(t, anniversary, holiday)
+
+	dayType, err := c.GetDayType(time.Date(2030, 1, 1, 0, 0, 0, 0, ChinaStandardTime))
+	assert.NoError(t, err)
+	assert.Equal(t, StatutoryHoliday, dayType)
+
+	n, err := c.CountWorkdays(time.Date(2030, 6, 1, 0, 0, 0, 0, ChinaStandardTime), time.Date(2030, 6, 30, 0, 0, 0, 0, ChinaStandardTime))
+	assert.NoError(t, err)
+	assert.Equal(t, 20, n)
+
+	assert.Equal(t, 3, len(c.Arrangements()))
+}
+
+func TestNewCalendarInvalid(t *testing.T) {
+	start, end := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2030, 12, 31, 0, 0, 0, 0, time.UTC)
+	invalid := [][]Arrangement{
+		{{Date: time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC), Holiday: NewYearsDay}},
+		{{Date: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}},
+		{{Date: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), Holiday: NewYearsDay, Workday: true, Statutory: true}},
+		{
+			{Date: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), Holiday: NewYearsDay},
+			{Date: time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC), Holiday: NewYearsDay},
+		},
+	}
+	for _, arrangements := range invalid {
+		_, err := NewCalendar(start, end, arrangements)
+		assert.True(t, errors.Is(err, ErrInvalidArrangement), err)
+	}
+
+	_, err := NewCalendar(end, start, nil)
+	assert.True(t, errors.Is(err, ErrInvalidArrangement))
+}
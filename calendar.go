@@ -1,133 +1,314 @@
+// Package chinesecalendar 判断某一天是否为中国大陆的工作日/节假日。
+//
+// 所有接收 time.Time 的函数都按 t 在中国的日期判断：先转换为中国标准时间（t.In(ChinaStandardTime)），
+// 再取其年月日。同一时刻无论用哪个时区表示，结果都相同，与运行环境的 TZ 设置无关，
+// 例如 UTC 的 2022-09-30T20:00:00Z 为北京时间 10 月 1 日，按国庆节判断。
+// 只有日期而没有时刻的值请用 ChinaStandardTime 构造，例如 time.Date(2024, 10, 1, 0, 0, 0, 0, ChinaStandardTime)；
+// 用东八区以东时区的零点表示的日期在中国仍是前一天。
+// 返回的日期都是 ChinaStandardTime 的零点。
+//
+// 包级函数使用内置的国务院放假安排（见 Default），
+// 需要其他数据来源时可以用 NewCalendar 构建 Calendar。
 package chinesecalendar
 
-import "time"
+import (
+	"sync"
+	"time"
+)
 
-type validateHolidayFunc func(t time.Time) bool
+// Calendar 节假日日历，保存一段日期范围内的放假安排
+type Calendar struct {
+	minDay civilDate
+	maxDay civilDate
+	tables []yearTable
+
+	periodsOnce sync.Once
+	periods     []*holidayPeriod
+
+	versionOnce sync.Once
+	version     string
+
+	// projected 是否为推算的日历，见 ProjectCalendar
+	projected bool
+
+	// groups 部分公民放假的节日，香港、澳门、台湾的日历为空，见 AttendanceFor
+	groups []groupHoliday
+
+	// feed 日历的标识，例如 mainland、hongkong、mainland-guangxi，用于生成 iCalendar 事件的 UID
+	feed string
+}
+
+// subFeed 返回在 c 上叠加 name 得到的日历的标识
+func (c *Calendar) subFeed(name string) string {
+	if c.feed == "" {
+		return name
+	}
+	return c.feed + "-" + name
+}
 
 // IsWorkday 检查是否是工作日
-// return false if the t is not in the range from 2004 to 2022
-func IsWorkday(t time.Time) bool {
-	var isValidate bool
-	t, isValidate = validateDate(t)
+// return false if the t is not in the range of the calendar
+func (c *Calendar) IsWorkday(t time.Time) bool {
+	d, isValidate := c.validateDate(t)
 	if !isValidate {
 		return false
 	}
-	return isWorkday(t)
+	return c.isWorkday(d)
 }
 
-func isWorkday(t time.Time) bool {
-	if _, inWorkDay := workdays[t]; inWorkDay {
-		return true
-	}
-	weekday := t.Weekday()
-	if _, inHoliday := holidays[t]; !inHoliday && weekday >= 1 && weekday <= 5 {
-		return true
-	}
-
-	return false
+func (c *Calendar) isWorkday(d civilDate) bool {
+	tb, i := c.tableOf(d)
+	return tb.isWorkday(i)
 }
 
 // IsHoliday 检查是否节假日
-// return false if the t is not in the range from 2004 to 2022
-func IsHoliday(t time.Time) bool {
-	var isValidate bool
-	t, isValidate = validateDate(t)
+// return false if the t is not in the range of the calendar
+func (c *Calendar) IsHoliday(t time.Time) bool {
+	d, isValidate := c.validateDate(t)
 	if !isValidate {
 		return false
 	}
-	return isHoliday(t)
+	return c.isHoliday(d)
 }
 
-func isHoliday(t time.Time) bool {
-	return !isWorkday(t)
+func (c *Calendar) isHoliday(d civilDate) bool {
+	return !c.isWorkday(d)
 }
 
 // IsInLieu 检查是否调休日
-// return false if the t is not in the range from 2004 to 2022
-func IsInLieu(t time.Time) bool {
-	var isValidate bool
-	t, isValidate = validateDate(t)
+// return false if the t is not in the range of the calendar
+func (c *Calendar) IsInLieu(t time.Time) bool {
+	d, isValidate := c.validateDate(t)
 	if !isValidate {
 		return false
 	}
 
-	return isInLieu(t)
+	return c.isInLieu(d)
 }
 
-func isInLieu(t time.Time) bool {
-	_, isInLieuDay := inLieuDays[t]
-	return isInLieuDay
+func (c *Calendar) isInLieu(d civilDate) bool {
+	tb, i := c.tableOf(d)
+	return tb.inLieuDays.has(i)
+}
+
+// CheckWorkday 检查是否是工作日，与 IsWorkday 不同的是 t 超出支持范围时返回 *DateRangeError
+func (c *Calendar) CheckWorkday(t time.Time) (bool, error) {
+	d, isValidate := c.validateDate(t)
+	if !isValidate {
+		return false, c.rangeError(civilDateOf(t), ChinaStandardTime)
+	}
+	return c.isWorkday(d), nil
+}
+
+// CheckHoliday 检查是否节假日，与 IsHoliday 不同的是 t 超出支持范围时返回 *DateRangeError
+func (c *Calendar) CheckHoliday(t time.Time) (bool, error) {
+	d, isValidate := c.validateDate(t)
+	if !isValidate {
+		return false, c.rangeError(civilDateOf(t), ChinaStandardTime)
+	}
+	return c.isHoliday(d), nil
+}
+
+// CheckInLieu 检查是否调休日，与 IsInLieu 不同的是 t 超出支持范围时返回 *DateRangeError
+func (c *Calendar) CheckInLieu(t time.Time) (bool, error) {
+	d, isValidate := c.validateDate(t)
+	if !isValidate {
+		return false, c.rangeError(civilDateOf(t), ChinaStandardTime)
+	}
+	return c.isInLieu(d), nil
 }
 
 // GetHolidayDetail 获取节假日详细信息
-func GetHolidayDetail(t time.Time) (Holiday, bool) {
-	var isValidate bool
-	t, isValidate = validateDate(t)
+func (c *Calendar) GetHolidayDetail(t time.Time) (Holiday, bool) {
+	d, isValidate := c.validateDate(t)
 	if !isValidate {
 		return Holiday{}, false
 	}
 
-	if _, ok := workdays[t]; ok {
+	tb, i := c.tableOf(d)
+	if tb.workdays.has(i) {
 		return Holiday{}, false
 	}
 
-	if hd, ok := holidays[t]; ok {
-		return hd, true
+	if tb.holidays.has(i) {
+		return tb.holidayAt(i)
 	}
-	weekday := t.Weekday()
-	return Holiday{}, weekday == 0 || weekday == 6
+	return Holiday{}, tb.weekends().has(i)
 }
 
-func getDates(start, end time.Time, fn validateHolidayFunc) []time.Time {
-	days := int(end.Sub(start)/oneDay + 1)
-	list := make([]time.Time, 0)
-	for i := 0; i < days; i++ {
-		t := start.Add(time.Duration(i * int(oneDay)))
-		if fn(t) {
-			list = append(list, t)
-		}
+// Interval 区间是否包含结束日期。
+// end 早于 start，或半开区间的 start 与 end 为同一天时，区间为空：
+// 统计结果为 0、日期列表为空，不检查日期是否在支持范围内，也不返回错误
+type Interval int
+
+const (
+	// Closed 闭区间 [start, end]，与 GetHolidays、GetWorkdays 一致
+	Closed Interval = iota
+	// HalfOpen 半开区间 [start, end)，不包含结束日期
+	HalfOpen
+)
+
+// validateRange 取区间的起止日期并检查是否在支持范围内，半开区间的结束日期取 end 的前一天。
+// 区间为空时（见 Interval）返回的结束日期早于开始日期，不做范围检查
+func (c *Calendar) validateRange(start, end time.Time, interval ...Interval) (civilDate, civilDate, error) {
+	startDay, endDay := civilDateOf(start), civilDateOf(end)
+	if len(interval) > 0 && interval[0] == HalfOpen {
+		endDay = endDay.addDays(-1)
+	}
+	if endDay.before(startDay) {
+		return startDay, endDay, nil
 	}
+	if !c.isSupported(startDay) {
+		return civilDate{}, civilDate{}, c.rangeError(startDay, ChinaStandardTime)
+	}
+	if !c.isSupported(endDay) {
+		return civilDate{}, civilDate{}, c.rangeError(endDay, ChinaStandardTime)
+	}
+	return startDay, endDay, nil
+}
+
+// countDates 返回 [start, end] 内 pick 选中的天数
+func (c *Calendar) countDates(start, end civilDate, pick func(tb *yearTable) dayBits) int {
+	n := 0
+	c.eachYear(start, end, func(tb *yearTable, from, to int) {
+		b := pick(tb)
+		n += b.count(from, to)
+	})
+	return n
+}
+
+// getDates 返回 [start, end] 内 pick 选中的日期，以 loc 时区的零点表示
+func (c *Calendar) getDates(start, end civilDate, loc *time.Location, pick func(tb *yearTable) dayBits) []time.Time {
+	list := make([]time.Time, 0, c.countDates(start, end, pick))
+	c.eachYear(start, end, func(tb *yearTable, from, to int) {
+		b := pick(tb)
+		b.each(from, to, func(i int) {
+			list = append(list, dateOfYearDay(tb.year, i).time(loc))
+		})
+	})
 	return list
 }
 
+// pickHolidays 选出节假日，includeWeekends 为 false 时只包括放假安排中的日期
+func pickHolidays(includeWeekends bool) func(tb *yearTable) dayBits {
+	if includeWeekends {
+		return (*yearTable).restdayBits
+	}
+	return func(tb *yearTable) dayBits {
+		return tb.holidays
+	}
+}
+
 // GetHolidays 获取时间区间内的节假日（包括起止时间），如果日期不符合，返回空切片
-func GetHolidays(start, end time.Time, includeWeekends bool) ([]time.Time, error) {
-	var isValidate bool
-	start, isValidate = validateDate(start)
-	if !isValidate {
-		return []time.Time{}, ErrUnSupportDate
+// 返回的日期为 ChinaStandardTime 的零点
+func (c *Calendar) GetHolidays(start, end time.Time, includeWeekends bool) ([]time.Time, error) {
+	startDay, endDay, err := c.validateRange(start, end)
+	if err != nil {
+		return []time.Time{}, err
+	}
+
+	return c.getDates(startDay, endDay, ChinaStandardTime, pickHolidays(includeWeekends)), nil
+}
+
+// GetWorkdays 获取时间区间内的（包括起止时间），如果日期不符合，返回空切片
+// 返回的日期为 ChinaStandardTime 的零点
+func (c *Calendar) GetWorkdays(start, end time.Time) ([]time.Time, error) {
+	startDay, endDay, err := c.validateRange(start, end)
+	if err != nil {
+		return []time.Time{}, err
+	}
+
+	return c.getDates(startDay, endDay, ChinaStandardTime, (*yearTable).workdayBits), nil
+}
+
+// CountWorkdays 统计时间区间内的工作日天数，默认包括起止时间，传入 HalfOpen 时不包括结束日期，
+// end 早于 start 等区间为空的情况返回 0（见 Interval）
+func (c *Calendar) CountWorkdays(start, end time.Time, interval ...Interval) (int, error) {
+	startDay, endDay, err := c.validateRange(start, end, interval...)
+	if err != nil {
+		return 0, err
 	}
-	end, isValidate = validateDate(end)
+
+	return c.countDates(startDay, endDay, (*yearTable).workdayBits), nil
+}
+
+// CountHolidays 统计时间区间内的节假日天数，默认包括起止时间，传入 HalfOpen 时不包括结束日期，
+// end 早于 start 等区间为空的情况返回 0（见 Interval）
+// includeWeekends 的含义与 GetHolidays 相同
+func (c *Calendar) CountHolidays(start, end time.Time, includeWeekends bool, interval ...Interval) (int, error) {
+	startDay, endDay, err := c.validateRange(start, end, interval...)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.countDates(startDay, endDay, pickHolidays(includeWeekends)), nil
+}
+
+// AddWorkdays 返回 t 之后第 n 个工作日（n 为负数时为之前第 -n 个），n 为 0 时返回 t 当天。
+// 调休上班日计为工作日。返回的日期为 ChinaStandardTime 的零点，
+// 如果 t 或计算过程中经过的日期超出支持范围，返回 ErrUnSupportDate
+func (c *Calendar) AddWorkdays(t time.Time, n int) (time.Time, error) {
+	d, isValidate := c.validateDate(t)
 	if !isValidate {
-		return []time.Time{}, ErrUnSupportDate
+		return time.Time{}, c.rangeError(civilDateOf(t), ChinaStandardTime)
 	}
 
-	list := make([]time.Time, 0)
-	if includeWeekends {
-		list = getDates(start, end, IsHoliday)
-	} else {
-		days := int(end.Sub(start)/oneDay + 1)
-		for i := 0; i < days; i++ {
-			t := start.Add(time.Duration(i * int(oneDay)))
-			if _, ok := holidays[t]; ok {
-				list = append(list, t)
-			}
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		d = d.addDays(step)
+		if !c.isSupported(d) {
+			return time.Time{}, c.rangeError(d, ChinaStandardTime)
+		}
+		if c.isWorkday(d) {
+			n--
 		}
 	}
-	return list, nil
+	return d.time(ChinaStandardTime), nil
 }
 
-// GetWorkdays 获取时间区间内的（包括起止时间），如果日期不符合，返回空切片
-func GetWorkdays(start, end time.Time) ([]time.Time, error) {
-	var isValidate bool
-	start, isValidate = validateDate(start)
+// SubWorkdays 返回 t 之前第 n 个工作日，等同于 AddWorkdays(t, -n)
+func (c *Calendar) SubWorkdays(t time.Time, n int) (time.Time, error) {
+	return c.AddWorkdays(t, -n)
+}
+
+// seek 从 t 的下一天（step 为 -1 时为前一天）开始逐日查找第一个满足 fn 的日期，
+// 超出支持范围时返回 ErrUnSupportDate
+func (c *Calendar) seek(t time.Time, step int, fn func(d civilDate) bool) (time.Time, error) {
+	d, isValidate := c.validateDate(t)
 	if !isValidate {
-		return []time.Time{}, ErrUnSupportDate
+		return time.Time{}, c.rangeError(civilDateOf(t), ChinaStandardTime)
 	}
-	end, isValidate = validateDate(end)
-	if !isValidate {
-		return []time.Time{}, ErrUnSupportDate
+
+	for {
+		d = d.addDays(step)
+		if !c.isSupported(d) {
+			return time.Time{}, c.rangeError(d, ChinaStandardTime)
+		}
+		if fn(d) {
+			return d.time(ChinaStandardTime), nil
+		}
 	}
+}
+
+// NextWorkday 返回 t 之后的第一个工作日，日期为 ChinaStandardTime 的零点
+func (c *Calendar) NextWorkday(t time.Time) (time.Time, error) {
+	return c.seek(t, 1, c.isWorkday)
+}
+
+// PrevWorkday 返回 t 之前的最后一个工作日，日期为 ChinaStandardTime 的零点
+func (c *Calendar) PrevWorkday(t time.Time) (time.Time, error) {
+	return c.seek(t, -1, c.isWorkday)
+}
+
+// NextHoliday 返回 t 之后的第一个节假日（包括周末），日期为 ChinaStandardTime 的零点
+func (c *Calendar) NextHoliday(t time.Time) (time.Time, error) {
+	return c.seek(t, 1, c.isHoliday)
+}
 
-	return getDates(start, end, isWorkday), nil
+// PrevHoliday 返回 t 之前的最后一个节假日（包括周末），日期为 ChinaStandardTime 的零点
+func (c *Calendar) PrevHoliday(t time.Time) (time.Time, error) {
+	return c.seek(t, -1, c.isHoliday)
 }
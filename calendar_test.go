@@ -1,17 +1,116 @@
 package chinesecalendar
 
 import (
+	"fmt"
+	"os"
+	"os/exec"
 	"testing"
 	"time"
 
+	_ "time/tzdata"
+
 	"github.com/stretchr/testify/assert"
 )
 
+var testZones = []string{"UTC", "Asia/Shanghai", "Asia/Tokyo", "Pacific/Kiritimati", "America/New_York", "America/Los_Angeles"}
+
+// tzSuiteEnv 子进程的环境变量，避免重复运行时区矩阵
+const tzSuiteEnv = "CHINESECALENDAR_TZ_SUITE"
+
+// TestMain 在每个 TZ 设置下重新运行全部用例，结果不应依赖运行环境的时区
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if os.Getenv(tzSuiteEnv) != "" {
+		os.Exit(code)
+	}
+	for _, name := range testZones {
+		cmd := exec.Command(os.Args[0], os.Args[1:]...)
+		cmd.Env = append(os.Environ(), "TZ="+name, tzSuiteEnv+"=1")
+		cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+		if err := cmd.Run(); err != nil {
+			fmt.Fprintf(os.Stderr, "TZ=%s: %v\n", name, err)
+			code = 1
+		}
+	}
+	os.Exit(code)
+}
+
+// TestResultLocation 不以 t 为参数的结果使用 ChinaStandardTime，与运行环境的时区无关
+func TestResultLocation(t *testing.T) {
+	start, end := SupportedRange()
+	assert.Equal(t, ChinaStandardTime, start.Location())
+	assert.Equal(t, ChinaStandardTime, end.Location())
+
+	arrangements := Default().Arrangements()
+	assert.Equal(t, time.Date(2004, 1, 1, 0, 0, 0, 0, ChinaStandardTime), arrangements[0].Date)
+
+	periods, err := GetHolidayPeriods(2024)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 10, 7, 0, 0, 0, 0, ChinaStandardTime), periods[len(periods)-1].End)
+
+	list, err := ProjectHolidays(2027)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2027, 1, 1, 0, 0, 0, 0, ChinaStandardTime), list[0].Date)
+}
+
+// TestForeignLocation 任何时区的时间都按其在中国的日期判断
+func TestForeignLocation(t *testing.T) {
+	for _, name := range testZones {
+		loc, err := time.LoadLocation(name)
+		assert.NoError(t, err)
+
+		// 2022-10-06 12:00 北京时间在各时区的表示
+		instant := time.Date(2022, 10, 6, 12, 0, 0, 0, ChinaStandardTime).In(loc)
+		assert.Equal(t, true, IsHoliday(instant), name)
+		assert.Equal(t, true, IsWorkday(instant.AddDate(0, 0, 2)), name)
+		assert.Equal(t, true, IsInLieu(time.Date(2022, 2, 3, 12, 0, 0, 0, ChinaStandardTime).In(loc)), name)
+
+		holiday, isHoliday := GetHolidayDetail(time.Date(2022, 1, 1, 8, 0, 0, 0, ChinaStandardTime).In(loc))
+		assert.Equal(t, true, isHoliday, name)
+		assert.Equal(t, NewYearsDay, holiday, name)
+
+		next, err := NextWorkday(instant)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2022, 10, 8, 0, 0, 0, 0, ChinaStandardTime), next, name)
+	}
+
+	// UTC 的 2022-09-30 20:00 为北京时间 10 月 1 日
+	instant := time.Date(2022, 9, 30, 20, 0, 0, 0, time.UTC)
+	assert.Equal(t, true, IsHoliday(instant))
+	assert.Equal(t, false, IsWorkday(instant))
+	assert.Equal(t, true, IsWorkday(time.Date(2022, 9, 30, 15, 59, 59, 0, time.UTC)))
+	// 东八区以东时区的零点在中国仍是前一天
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+	assert.Equal(t, false, IsHoliday(time.Date(2022, 10, 1, 0, 0, 0, 0, tokyo)))
+}
+
+func TestGetWorkdays(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	// 跨越夏令时切换（2022-03-13），结果为北京时间的零点
+	days, err := GetWorkdays(time.Date(2022, 3, 11, 0, 0, 0, 0, loc), time.Date(2022, 3, 15, 0, 0, 0, 0, loc))
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2022, 3, 11, 0, 0, 0, 0, ChinaStandardTime),
+		time.Date(2022, 3, 14, 0, 0, 0, 0, ChinaStandardTime),
+		time.Date(2022, 3, 15, 0, 0, 0, 0, ChinaStandardTime),
+	}, days)
+
+	holidays, err := GetHolidays(time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2022, 10, 9, 0, 0, 0, 0, time.UTC), false)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, len(holidays))
+	assert.Equal(t, time.Date(2022, 10, 7, 0, 0, 0, 0, ChinaStandardTime), holidays[6])
+}
+
 func TestIsHoliday(t *testing.T) {
 	dates := []time.Time{
-		time.Date(2004, 1, 1, 0, 0, 0, 0, time.Local),
-		time.Date(2017, 5, 30, 0, 0, 0, 0, time.Local),
-		time.Date(2022, 10, 6, 0, 0, 0, 0, time.Local),
+		time.Date(2004, 1, 1, 0, 0, 0, 0, ChinaStandardTime),
+		time.Date(2017, 5, 30, 0, 0, 0, 0, ChinaStandardTime),
+		time.Date(2022, 10, 6, 0, 0, 0, 0, ChinaStandardTime),
+		time.Date(2016, 2, 8, 0, 0, 0, 0, ChinaStandardTime),
+		time.Date(2017, 10, 8, 0, 0, 0, 0, ChinaStandardTime),
 	}
 	for _, date := range dates {
 		assert.Equal(t, true, IsHoliday(date))
@@ -21,9 +120,10 @@ func TestIsHoliday(t *testing.T) {
 
 func TestIsWorkDay(t *testing.T) {
 	dates := []time.Time{
-		time.Date(2004, 1, 5, 0, 0, 0, 0, time.Local),
-		time.Date(2021, 2, 25, 0, 0, 0, 0, time.Local),
-		time.Date(2022, 2, 25, 0, 0, 0, 0, time.Local),
+		time.Date(2004, 1, 5, 0, 0, 0, 0, ChinaStandardTime),
+		time.Date(2021, 2, 25, 0, 0, 0, 0, ChinaStandardTime),
+		time.Date(2022, 2, 25, 0, 0, 0, 0, ChinaStandardTime),
+		time.Date(2016, 2, 6, 0, 0, 0, 0, ChinaStandardTime),
 	}
 	for _, date := range dates {
 		assert.Equal(t, true, IsWorkday(date))
@@ -36,9 +136,9 @@ func TestGetHolidayDetail(t *testing.T) {
 		date          time.Time
 		expectHoliday Holiday
 	}{
-		{time.Date(2004, 1, 1, 0, 0, 0, 0, time.Local), NewYearsDay},
-		{time.Date(2014, 4, 7, 0, 0, 0, 0, time.Local), TombSweepingDay},
-		{time.Date(2022, 9, 10, 0, 0, 0, 0, time.Local), MidAutumnFestival},
+		{time.Date(2004, 1, 1, 0, 0, 0, 0, ChinaStandardTime), NewYearsDay},
+		{time.Date(2014, 4, 7, 0, 0, 0, 0, ChinaStandardTime), TombSweepingDay},
+		{time.Date(2022, 9, 10, 0, 0, 0, 0, ChinaStandardTime), MidAutumnFestival},
 	}
 
 	for _, arg := range args {
@@ -50,8 +150,8 @@ func TestGetHolidayDetail(t *testing.T) {
 
 func TestOverRangeDate(t *testing.T) {
 	dates := []time.Time{
-		time.Date(2001, 1, 5, 0, 0, 0, 0, time.Local),
-		time.Date(2088, 2, 25, 0, 0, 0, 0, time.Local),
+		time.Date(2001, 1, 5, 0, 0, 0, 0, ChinaStandardTime),
+		time.Date(2088, 2, 25, 0, 0, 0, 0, ChinaStandardTime),
 	}
 
 	for _, date := range dates {
@@ -60,3 +160,153 @@ func TestOverRangeDate(t *testing.T) {
 		assert.Equal(t, false, IsInLieu(date))
 	}
 }
+
+func TestCheckDate(t *testing.T) {
+	ok, err := CheckWorkday(time.Date(2024, 10, 12, 0, 0, 0, 0, ChinaStandardTime))
+	assert.NoError(t, err)
+	assert.Equal(t, true, ok)
+	ok, err = CheckHoliday(time.Date(2024, 10, 1, 0, 0, 0, 0, ChinaStandardTime))
+	assert.NoError(t, err)
+	assert.Equal(t, true, ok)
+	ok, err = CheckInLieu(time.Date(2024, 10, 1, 0, 0, 0, 0, ChinaStandardTime))
+	assert.NoError(t, err)
+	assert.Equal(t, false, ok)
+
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	_, err = CheckWorkday(time.Date(2088, 2, 25, 12, 0, 0, 0, loc))
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+	var rangeErr *DateRangeError
+	assert.ErrorAs(t, err, &rangeErr)
+	// UTC-5 的 2 月 25 日 12:00 为北京时间 2 月 26 日
+	assert.Equal(t, time.Date(2088, 2, 26, 0, 0, 0, 0, ChinaStandardTime), rangeErr.Date)
+	assert.Equal(t, time.Date(2004, 1, 1, 0, 0, 0, 0, ChinaStandardTime), rangeErr.Min)
+	assert.Equal(t, time.Date(2024, 10, 12, 0, 0, 0, 0, ChinaStandardTime), rangeErr.Max)
+	assert.Equal(t, false, rangeErr.Before())
+	assert.Equal(t, true, rangeErr.After())
+	assert.Equal(t, "unsupported date 2088-02-26, supported date range is 2004-01-01 - 2024-10-12", err.Error())
+
+	_, err = CheckHoliday(time.Date(2001, 1, 5, 0, 0, 0, 0, ChinaStandardTime))
+	assert.ErrorAs(t, err, &rangeErr)
+	assert.Equal(t, true, rangeErr.Before())
+	_, err = CheckInLieu(time.Date(2001, 1, 5, 0, 0, 0, 0, ChinaStandardTime))
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+
+	// 区间函数报告超出范围的一端
+	_, err = CountWorkdays(time.Date(2024, 1, 1, 0, 0, 0, 0, ChinaStandardTime), time.Date(2025, 3, 1, 0, 0, 0, 0, ChinaStandardTime))
+	assert.ErrorAs(t, err, &rangeErr)
+	assert.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, ChinaStandardTime), rangeErr.Date)
+	_, err = AddWorkdays(time.Date(2004, 1, 5, 0, 0, 0, 0, ChinaStandardTime), -3)
+	assert.ErrorAs(t, err, &rangeErr)
+	assert.Equal(t, time.Date(2003, 12, 31, 0, 0, 0, 0, ChinaStandardTime), rangeErr.Date)
+}
+
+func TestAddWorkdays(t *testing.T) {
+	args := []struct {
+		date   time.Time
+		n      int
+		expect time.Time
+	}{
+		{time.Date(2024, 9, 30, 0, 0, 0, 0, ChinaStandardTime), 5, time.Date(2024, 10, 12, 0, 0, 0, 0, ChinaStandardTime)},
+		{time.Date(2024, 9, 30, 15, 30, 0, 0, ChinaStandardTime), 0, time.Date(2024, 9, 30, 0, 0, 0, 0, ChinaStandardTime)},
+		{time.Date(2024, 2, 9, 0, 0, 0, 0, ChinaStandardTime), 1, time.Date(2024, 2, 18, 0, 0, 0, 0, ChinaStandardTime)},
+		{time.Date(2024, 2, 18, 0, 0, 0, 0, ChinaStandardTime), -1, time.Date(2024, 2, 9, 0, 0, 0, 0, ChinaStandardTime)},
+		{time.Date(2022, 1, 4, 0, 0, 0, 0, time.UTC), -1, time.Date(2021, 12, 31, 0, 0, 0, 0, ChinaStandardTime)},
+	}
+	for _, arg := range args {
+		got, err := AddWorkdays(arg.date, arg.n)
+		assert.NoError(t, err)
+		assert.Equal(t, arg.expect, got)
+	}
+
+	got, err := SubWorkdays(time.Date(2024, 10, 12, 0, 0, 0, 0, ChinaStandardTime), 5)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 9, 30, 0, 0, 0, 0, ChinaStandardTime), got)
+
+	_, err = AddWorkdays(time.Date(2004, 1, 5, 0, 0, 0, 0, ChinaStandardTime), -3)
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+	_, err = AddWorkdays(time.Date(2001, 1, 5, 0, 0, 0, 0, ChinaStandardTime), 1)
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+}
+
+func TestCountDays(t *testing.T) {
+	start := time.Date(2024, 9, 28, 0, 0, 0, 0, ChinaStandardTime)
+	end := time.Date(2024, 10, 8, 0, 0, 0, 0, ChinaStandardTime)
+
+	n, err := CountWorkdays(start, end)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	n, err = CountWorkdays(start, end, HalfOpen)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	n, err = CountHolidays(start, end, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, n)
+	n, err = CountHolidays(start, end, true, Closed)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, n)
+
+	// 与 GetWorkdays 的结果一致
+	start, end = time.Date(2004, 1, 1, 0, 0, 0, 0, ChinaStandardTime), time.Date(2024, 10, 12, 0, 0, 0, 0, ChinaStandardTime)
+	days, err := GetWorkdays(start, end)
+	assert.NoError(t, err)
+	n, err = CountWorkdays(start, end)
+	assert.NoError(t, err)
+	assert.Equal(t, len(days), n)
+
+	// end 早于 start 时区间为空
+	n, err = CountWorkdays(end, start)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+	n, err = CountHolidays(time.Date(2024, 10, 12, 0, 0, 0, 0, ChinaStandardTime), time.Date(2003, 6, 1, 0, 0, 0, 0, ChinaStandardTime), true)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+	days, err = GetWorkdays(end, start)
+	assert.NoError(t, err)
+	assert.Empty(t, days)
+
+	// 半开区间的起止日期相同时区间为空，不检查前一天是否在范围内
+	n, err = CountWorkdays(start, start, HalfOpen)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+	n, err = CountHolidays(start, start, true, HalfOpen)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+	n, err = CountWorkdays(start, start.AddDate(0, 0, 1), HalfOpen)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+	n, err = CountHolidays(start, start.AddDate(0, 0, 1), true, HalfOpen)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	_, err = CountWorkdays(start, time.Date(2088, 1, 1, 0, 0, 0, 0, ChinaStandardTime))
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+}
+
+func TestNextAndPrev(t *testing.T) {
+	// 北京时间 2024-10-01 02:00
+	date := time.Date(2024, 9, 30, 18, 0, 0, 0, time.UTC)
+
+	next, err := NextWorkday(date)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 10, 8, 0, 0, 0, 0, ChinaStandardTime), next)
+
+	prev, err := PrevWorkday(date)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 9, 30, 0, 0, 0, 0, ChinaStandardTime), prev)
+
+	next, err = NextHoliday(date)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 10, 2, 0, 0, 0, 0, ChinaStandardTime), next)
+
+	prev, err = PrevHoliday(date)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 9, 28, 0, 0, 0, 0, ChinaStandardTime), prev)
+
+	_, err = PrevHoliday(time.Date(2004, 1, 1, 0, 0, 0, 0, ChinaStandardTime))
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+	_, err = NextWorkday(time.Date(2024, 12, 31, 0, 0, 0, 0, ChinaStandardTime))
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+	_, err = NextWorkday(time.Date(2088, 1, 1, 0, 0, 0, 0, ChinaStandardTime))
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+}
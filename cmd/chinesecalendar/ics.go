@@ -0,0 +1,51 @@
+package main
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+)
+
+func runICS(args []string, stdout, stderr io.Writer) error {
+	fs, data := newFlagSet("ics", stderr)
+	output := fs.String("o", "", "output file, defaults to stdout")
+	positional, err := parseArgs(fs, args, 2)
+	if err != nil {
+		return err
+	}
+
+	c, err := loadCalendar(*data)
+	if err != nil {
+		return err
+	}
+	start, err := parseDate(positional[0])
+	if err != nil {
+		return err
+	}
+	end, err := parseDate(positional[1])
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		return c.WriteICS(stdout, start, end)
+	}
+	// 先写入同一目录下的临时文件，成功后再改名，出错时不留下不完整的文件
+	file, err := os.CreateTemp(filepath.Dir(*output), ".chinesecalendar-*.ics")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if err := c.WriteICS(file, start, end); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Chmod(0o644); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), *output)
+}
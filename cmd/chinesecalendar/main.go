@@ -0,0 +1,156 @@
+// chinesecalendar 查询中国节假日的命令行工具
+//
+//	chinesecalendar is-workday DATE
+//	chinesecalendar list holidays|workdays|periods [-year YEAR]
+//	chinesecalendar add-workdays DATE N
+//	chinesecalendar count [-half-open] START END
+//	chinesecalendar ics [-o file.ics] START END
+//	chinesecalendar serve [-addr localhost:8080]
+//
+// 日期格式为 2006-01-02。所有子命令都支持 -data 指定 JSON 数据文件（格式见 Calendar.WriteJSON）
+// 或 iCalendar 文件（扩展名为 .ics，见 LoadICS），默认使用内置数据；
+// 查询类子命令都支持 -format 指定输出格式，默认为表格。
+//
+// 选项可以写在位置参数之后，例如 chinesecalendar list holidays --year 2024 -format csv。
+// list 不指定 -year 时列出数据覆盖的最后一年。
+//
+// 退出码：0 成功，1 其他错误（如日期格式、数据文件错误），2 参数错误，3 日期不在数据范围内
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/wangzeping722/chinesecalendar"
+)
+
+const (
+	exitOK          = 0
+	exitError       = 1
+	exitUsage       = 2
+	exitUnsupported = 3
+)
+
+// errUsage 参数错误，错误信息已输出
+var errUsage = errors.New("usage")
+
+type command struct {
+	name  string
+	usage string
+	run   func(args []string, stdout, stderr io.Writer) error
+}
+
+var commands = []command{
+	{"is-workday", "is-workday [-format table|json|csv] DATE", runIsWorkday},
+	{"list", "list holidays|workdays|periods [-year YEAR] [-format table|json|csv]", runList},
+	{"add-workdays", "add-workdays [-format table|json|csv] DATE N", runAddWorkdays},
+	{"count", "count [-half-open] [-format table|json|csv] START END", runCount},
+	{"ics", "ics [-o file.ics] START END", runICS},
+	{"serve", "serve [-addr localhost:8080]", runServe},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return exitUsage
+	}
+	for _, cmd := range commands {
+		if cmd.name != args[0] {
+			continue
+		}
+		err := cmd.run(args[1:], stdout, stderr)
+		switch {
+		case err == nil:
+			return exitOK
+		case errors.Is(err, errUsage):
+			return exitUsage
+		case errors.Is(err, chinesecalendar.ErrUnSupportDate):
+			fmt.Fprintf(stderr, "chinesecalendar %s: %v\n", cmd.name, err)
+			return exitUnsupported
+		default:
+			fmt.Fprintf(stderr, "chinesecalendar %s: %v\n", cmd.name, err)
+			return exitError
+		}
+	}
+	fmt.Fprintf(stderr, "chinesecalendar: unknown command %q\n", args[0])
+	usage(stderr)
+	return exitUsage
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  chinesecalendar %s\n", cmd.usage)
+	}
+	fmt.Fprintln(w, "all commands accept -data FILE to load a JSON or .ics data file instead of the built-in data")
+}
+
+// newFlagSet 创建子命令的参数解析，-data 指定数据文件
+func newFlagSet(cmd string, stderr io.Writer) (*flag.FlagSet, *string) {
+	fs := flag.NewFlagSet(cmd, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	data := fs.String("data", "", "JSON or iCalendar (.ics) data file, defaults to the built-in data")
+	return fs, data
+}
+
+var negativeNumber = regexp.MustCompile(`^-\d+$`)
+
+// parseArgs 解析参数，选项可以出现在位置参数之后，要求恰好 n 个位置参数
+func parseArgs(fs *flag.FlagSet, args []string, n int) ([]string, error) {
+	var positional []string
+	for {
+		// 负数（如 add-workdays 的 -3）是位置参数而不是选项
+		if len(args) > 0 && negativeNumber.MatchString(args[0]) {
+			positional = append(positional, args[0])
+			args = args[1:]
+			continue
+		}
+		if err := fs.Parse(args); err != nil {
+			return nil, errUsage
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+	if len(positional) != n {
+		fmt.Fprintf(fs.Output(), "%s: expected %d arguments, got %d\n", fs.Name(), n, len(positional))
+		return nil, errUsage
+	}
+	return positional, nil
+}
+
+func loadCalendar(path string) (*chinesecalendar.Calendar, error) {
+	if path == "" {
+		return chinesecalendar.Default(), nil
+	}
+	if strings.EqualFold(filepath.Ext(path), ".ics") {
+		return chinesecalendar.LoadICSFile(path, nil)
+	}
+	return chinesecalendar.LoadJSONFile(path)
+}
+
+func parseDate(s string) (time.Time, error) {
+	t, err := time.ParseInLocation("2006-01-02", s, chinesecalendar.ChinaStandardTime)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", s)
+	}
+	return t, nil
+}
+
+func formatDate(t time.Time) string {
+	return t.Format("2006-01-02")
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wangzeping722/chinesecalendar"
+)
+
+func runCommand(args ...string) (int, string, string) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	code := run(args, stdout, stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestIsWorkday(t *testing.T) {
+	code, stdout, _ := runCommand("is-workday", "2024-10-12", "-format", "csv")
+	assert.Equal(t, exitOK, code)
+	assert.Equal(t, "date,weekday,workday,dayType,holiday,projected\n2024-10-12,Saturday,true,调休上班,,false\n", stdout)
+
+	code, stdout, _ = runCommand("is-workday", "-format", "json", "2024-10-01")
+	assert.Equal(t, exitOK, code)
+	assert.Equal(t, "[\n  {\"date\": \"2024-10-01\", \"weekday\": \"Tuesday\", \"workday\": false, \"dayType\": \"法定假日\", \"holiday\": \"国庆节\", \"projected\": false}\n]\n", stdout)
+}
+
+func TestList(t *testing.T) {
+	code, stdout, _ := runCommand("list", "holidays", "--year", "2024", "-format", "csv")
+	assert.Equal(t, exitOK, code)
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	assert.Equal(t, 29, len(lines))
+	assert.Equal(t, "2024-01-01,Monday,元旦,New Year's Day,法定假日,true,false", lines[1])
+
+	code, stdout, _ = runCommand("list", "workdays", "-year", "2024", "-format", "csv")
+	assert.Equal(t, exitOK, code)
+	assert.Equal(t, 9, len(strings.Split(strings.TrimSpace(stdout), "\n")))
+
+	code, stdout, _ = runCommand("list", "periods", "-year", "2023")
+	assert.Equal(t, exitOK, code)
+	assert.Contains(t, stdout, "2023-09-29  2023-10-06  8     中秋、国庆节")
+
+	// 默认为数据覆盖的最后一年
+	code, stdout, _ = runCommand("list", "holidays", "-format", "csv")
+	assert.Equal(t, exitOK, code)
+	lines = strings.Split(strings.TrimSpace(stdout), "\n")
+	assert.Equal(t, 29, len(lines))
+	assert.True(t, strings.HasPrefix(lines[1], "2024-01-01,"))
+	code, stdout, _ = runCommand("list", "workdays", "-data", "../../testdata/2030.json", "-format", "csv")
+	assert.Equal(t, exitOK, code)
+	assert.Contains(t, stdout, "2030-02-09,")
+
+	code, _, stderr := runCommand("list", "months", "-year", "2023")
+	assert.Equal(t, exitUsage, code)
+	assert.Contains(t, stderr, `unknown kind "months"`)
+}
+
+func TestAddWorkdaysAndCount(t *testing.T) {
+	code, stdout, _ := runCommand("add-workdays", "2024-09-30", "5")
+	assert.Equal(t, exitOK, code)
+	assert.Equal(t, "DATE        WORKDAYS  RESULT      WEEKDAY\n2024-09-30  5         2024-10-12  Saturday\n", stdout)
+
+	code, stdout, _ = runCommand("add-workdays", "2024-10-08", "-3", "-format", "csv")
+	assert.Equal(t, exitOK, code)
+	assert.Contains(t, stdout, "2024-10-08,-3,2024-09-27,Friday")
+
+	code, stdout, _ = runCommand("count", "-format", "csv", "2024-10-01", "2024-10-08")
+	assert.Equal(t, exitOK, code)
+	assert.Contains(t, stdout, "2024-10-01,2024-10-08,8,1,7")
+	code, stdout, _ = runCommand("count", "-half-open", "-format", "csv", "2024-10-01", "2024-10-08")
+	assert.Equal(t, exitOK, code)
+	assert.Contains(t, stdout, "2024-10-01,2024-10-08,7,0,7")
+}
+
+func TestExitCodes(t *testing.T) {
+	code, _, stderr := runCommand("is-workday", "2030-01-01")
+	assert.Equal(t, exitUnsupported, code)
+	assert.Contains(t, stderr, "unsupported date")
+	code, _, _ = runCommand("list", "holidays", "-year", "2030")
+	assert.Equal(t, exitUnsupported, code)
+	code, _, _ = runCommand("add-workdays", "2024-10-08", "100")
+	assert.Equal(t, exitUnsupported, code)
+
+	code, _, _ = runCommand()
+	assert.Equal(t, exitUsage, code)
+	code, _, _ = runCommand("unknown")
+	assert.Equal(t, exitUsage, code)
+	code, _, _ = runCommand("count", "2024-01-01")
+	assert.Equal(t, exitUsage, code)
+	code, _, _ = runCommand("is-workday", "-data")
+	assert.Equal(t, exitUsage, code)
+
+	code, _, _ = runCommand("is-workday", "2024/01/01")
+	assert.Equal(t, exitError, code)
+	code, _, _ = runCommand("is-workday", "-format", "xml", "2024-01-01")
+	assert.Equal(t, exitError, code)
+	code, _, _ = runCommand("is-workday", "-data", "missing.json", "2024-01-01")
+	assert.Equal(t, exitError, code)
+}
+
+func TestDataFile(t *testing.T) {
+	code, stdout, _ := runCommand("is-workday", "-data", "../../testdata/2030.json", "-format", "csv", "2030-02-09")
+	assert.Equal(t, exitOK, code)
+	assert.Contains(t, stdout, "2030-02-09,Saturday,true,调休上班,")
+
+	code, stdout, _ = runCommand("list", "workdays", "-data", "../../testdata/company.ics", "-year", "2030", "-format", "csv")
+	assert.Equal(t, exitOK, code)
+	assert.Equal(t, "date,weekday,holiday,engName\n2030-02-09,Saturday,春节,Spring Festival\n", stdout)
+
+	// 推算的日历在输出中标明
+	projected, err := chinesecalendar.ProjectCalendar(2026, 2027)
+	assert.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "projected.json")
+	file, err := os.Create(path)
+	assert.NoError(t, err)
+	assert.NoError(t, projected.WriteJSON(file))
+	assert.NoError(t, file.Close())
+	code, stdout, _ = runCommand("is-workday", "-data", path, "-format", "csv", "2026-02-17")
+	assert.Equal(t, exitOK, code)
+	assert.Equal(t, "date,weekday,workday,dayType,holiday,projected\n2026-02-17,Tuesday,false,法定假日,春节,true\n", stdout)
+}
+
+func TestICSOutput(t *testing.T) {
+	dir := t.TempDir()
+	output := filepath.Join(dir, "holidays.ics")
+	code, _, _ := runCommand("ics", "-o", output, "2024-10-01", "2024-10-12")
+	assert.Equal(t, exitOK, code)
+	content, err := os.ReadFile(output)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "END:VCALENDAR")
+
+	// 出错时不留下不完整的文件
+	failed := filepath.Join(dir, "failed.ics")
+	code, _, _ = runCommand("ics", "-o", failed, "2024-10-01", "2030-01-01")
+	assert.Equal(t, exitUnsupported, code)
+	_, err = os.Stat(failed)
+	assert.True(t, os.IsNotExist(err))
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(entries))
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// table 查询结果，按 format 输出为表格、JSON 或 CSV
+type table struct {
+	header []string
+	rows   [][]interface{}
+}
+
+func (t *table) add(row ...interface{}) {
+	t.rows = append(t.rows, row)
+}
+
+// formatFlag 为子命令添加 -format 选项
+func formatFlag(fs *flag.FlagSet) *string {
+	return fs.String("format", "table", "output format: table, json or csv")
+}
+
+func (t *table) write(w io.Writer, format string) error {
+	switch format {
+	case "table":
+		return t.writeTable(w)
+	case "json":
+		return t.writeJSON(w)
+	case "csv":
+		return t.writeCSV(w)
+	}
+	return fmt.Errorf("unknown format %q, expected table, json or csv", format)
+}
+
+func (t *table) writeTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.ToUpper(strings.Join(t.header, "\t")))
+	for _, row := range t.rows {
+		cells := make([]string, len(row))
+		for i, v := range row {
+			cells[i] = fmt.Sprint(v)
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+// writeJSON 输出对象数组，字段名为表头，按表头顺序排列
+func (t *table) writeJSON(w io.Writer) error {
+	buffer := &bytes.Buffer{}
+	buffer.WriteString("[")
+	for k, row := range t.rows {
+		if k > 0 {
+			buffer.WriteString(",")
+		}
+		buffer.WriteString("\n  {")
+		for i, v := range row {
+			if s, ok := v.(fmt.Stringer); ok {
+				v = s.String()
+			}
+			value, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			if i > 0 {
+				buffer.WriteString(", ")
+			}
+			fmt.Fprintf(buffer, "%q: %s", t.header[i], value)
+		}
+		buffer.WriteString("}")
+	}
+	if len(t.rows) > 0 {
+		buffer.WriteString("\n")
+	}
+	buffer.WriteString("]\n")
+
+	_, err := w.Write(buffer.Bytes())
+	return err
+}
+
+func (t *table) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(t.header); err != nil {
+		return err
+	}
+	for _, row := range t.rows {
+		cells := make([]string, len(row))
+		for i, v := range row {
+			cells[i] = fmt.Sprint(v)
+		}
+		if err := cw.Write(cells); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
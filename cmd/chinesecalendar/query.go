@@ -0,0 +1,164 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/wangzeping722/chinesecalendar"
+)
+
+func runIsWorkday(args []string, stdout, stderr io.Writer) error {
+	fs, data := newFlagSet("is-workday", stderr)
+	format := formatFlag(fs)
+	positional, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	c, err := loadCalendar(*data)
+	if err != nil {
+		return err
+	}
+	t, err := parseDate(positional[0])
+	if err != nil {
+		return err
+	}
+
+	dayType, err := c.GetDayType(t)
+	if err != nil {
+		return err
+	}
+	holiday, _ := c.GetHolidayDetail(t)
+	result := &table{header: []string{"date", "weekday", "workday", "dayType", "holiday", "projected"}}
+	result.add(formatDate(t), t.Weekday(), c.IsWorkday(t), dayType, holiday.Name(), c.Projected())
+	return result.write(stdout, *format)
+}
+
+func runList(args []string, stdout, stderr io.Writer) error {
+	fs, data := newFlagSet("list", stderr)
+	format := formatFlag(fs)
+	year := fs.Int("year", 0, "year to list (default the last year in the data)")
+	positional, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	c, err := loadCalendar(*data)
+	if err != nil {
+		return err
+	}
+	if *year == 0 {
+		_, end := c.SupportedRange()
+		*year = end.Year()
+	}
+	// GetHolidayPeriods 同时检查年份是否在数据范围内
+	periods, err := c.GetHolidayPeriods(*year)
+	if err != nil {
+		return err
+	}
+
+	var result *table
+	switch positional[0] {
+	case "holidays":
+		result = &table{header: []string{"date", "weekday", "holiday", "engName", "dayType", "statutory", "inLieu"}}
+		for _, a := range c.Arrangements() {
+			if a.Workday || a.Date.Year() != *year {
+				continue
+			}
+			dayType, _ := c.GetDayType(a.Date)
+			result.add(formatDate(a.Date), a.Date.Weekday(), a.Holiday.Name(), a.Holiday.EngName(), dayType, a.Statutory, a.InLieu)
+		}
+	case "workdays":
+		result = &table{header: []string{"date", "weekday", "holiday", "engName"}}
+		for _, a := range c.Arrangements() {
+			if a.Workday && a.Date.Year() == *year {
+				result.add(formatDate(a.Date), a.Date.Weekday(), a.Holiday.Name(), a.Holiday.EngName())
+			}
+		}
+	case "periods":
+		result = &table{header: []string{"start", "end", "days", "holidays", "workdays"}}
+		for _, p := range periods {
+			names := make([]string, 0, len(p.Holidays))
+			for _, h := range p.Holidays {
+				names = append(names, h.Name())
+			}
+			workdays := make([]string, 0, len(p.Workdays))
+			for _, d := range p.Workdays {
+				workdays = append(workdays, formatDate(d))
+			}
+			result.add(formatDate(p.Start), formatDate(p.End), p.Days(), strings.Join(names, "、"), strings.Join(workdays, " "))
+		}
+	default:
+		fmt.Fprintf(stderr, "list: unknown kind %q, expected holidays, workdays or periods\n", positional[0])
+		return errUsage
+	}
+	return result.write(stdout, *format)
+}
+
+func runAddWorkdays(args []string, stdout, stderr io.Writer) error {
+	fs, data := newFlagSet("add-workdays", stderr)
+	format := formatFlag(fs)
+	positional, err := parseArgs(fs, args, 2)
+	if err != nil {
+		return err
+	}
+	c, err := loadCalendar(*data)
+	if err != nil {
+		return err
+	}
+	t, err := parseDate(positional[0])
+	if err != nil {
+		return err
+	}
+	n, err := strconv.Atoi(positional[1])
+	if err != nil {
+		fmt.Fprintf(stderr, "add-workdays: invalid number %q\n", positional[1])
+		return errUsage
+	}
+
+	day, err := c.AddWorkdays(t, n)
+	if err != nil {
+		return err
+	}
+	result := &table{header: []string{"date", "workdays", "result", "weekday"}}
+	result.add(formatDate(t), n, formatDate(day), day.Weekday())
+	return result.write(stdout, *format)
+}
+
+func runCount(args []string, stdout, stderr io.Writer) error {
+	fs, data := newFlagSet("count", stderr)
+	format := formatFlag(fs)
+	halfOpen := fs.Bool("half-open", false, "exclude END from the range")
+	positional, err := parseArgs(fs, args, 2)
+	if err != nil {
+		return err
+	}
+	c, err := loadCalendar(*data)
+	if err != nil {
+		return err
+	}
+	start, err := parseDate(positional[0])
+	if err != nil {
+		return err
+	}
+	end, err := parseDate(positional[1])
+	if err != nil {
+		return err
+	}
+
+	interval := chinesecalendar.Closed
+	if *halfOpen {
+		interval = chinesecalendar.HalfOpen
+	}
+	workdays, err := c.CountWorkdays(start, end, interval)
+	if err != nil {
+		return err
+	}
+	restDays, err := c.CountHolidays(start, end, true, interval)
+	if err != nil {
+		return err
+	}
+	result := &table{header: []string{"start", "end", "days", "workdays", "restDays"}}
+	result.add(formatDate(start), formatDate(end), workdays+restDays, workdays, restDays)
+	return result.write(stdout, *format)
+}
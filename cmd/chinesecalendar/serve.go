@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/wangzeping722/chinesecalendar/httpapi"
+)
+
+func runServe(args []string, stdout, stderr io.Writer) error {
+	fs, data := newFlagSet("serve", stderr)
+	addr := fs.String("addr", "localhost:8080", "listen address")
+	if _, err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+	c, err := loadCalendar(*data)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "serving data version %s on http://%s\n", c.Version(), *addr)
+	return http.ListenAndServe(*addr, httpapi.NewHandler(c))
+}
@@ -3,853 +3,396 @@
 // Code generated by "scripts/generator"; DO NOT EDIT.
 package chinesecalendar
 
-import (
-	"time"
-	. "github.com/wangzeping722/chinesecalendar/internal"
-)
-
 var (
 	// 节假日定义
-	minDay = Date(2004, 1, 1)
-	maxDay = Date(2024, 10, 12)
-	// 节假日
-	holidays = map[time.Time]Holiday{
-		Date(2004, 1, 1):NewYearsDay,
-		Date(2004, 1, 22):SpringFestival,
-		Date(2004, 1, 23):SpringFestival,
-		Date(2004, 1, 24):SpringFestival,
-		Date(2004, 1, 25):SpringFestival,
-		Date(2004, 1, 26):SpringFestival,
-		Date(2004, 1, 27):SpringFestival,
-		Date(2004, 1, 28):SpringFestival,
-		Date(2004, 5, 1):LabourDay,
-		Date(2004, 5, 2):LabourDay,
-		Date(2004, 5, 3):LabourDay,
-		Date(2004, 5, 4):LabourDay,
-		Date(2004, 5, 5):LabourDay,
-		Date(2004, 5, 6):LabourDay,
-		Date(2004, 5, 7):LabourDay,
-		Date(2004, 10, 1):NationalDay,
-		Date(2004, 10, 2):NationalDay,
-		Date(2004, 10, 3):NationalDay,
-		Date(2004, 10, 4):NationalDay,
-		Date(2004, 10, 5):NationalDay,
-		Date(2004, 10, 6):NationalDay,
-		Date(2004, 10, 7):NationalDay,
-		Date(2005, 1, 1):NewYearsDay,
-		Date(2005, 1, 2):NewYearsDay,
-		Date(2005, 1, 3):NewYearsDay,
-		Date(2005, 2, 9):SpringFestival,
-		Date(2005, 2, 10):SpringFestival,
-		Date(2005, 2, 11):SpringFestival,
-		Date(2005, 2, 12):SpringFestival,
-		Date(2005, 2, 13):SpringFestival,
-		Date(2005, 2, 14):SpringFestival,
-		Date(2005, 2, 15):SpringFestival,
-		Date(2005, 5, 1):LabourDay,
-		Date(2005, 5, 2):LabourDay,
-		Date(2005, 5, 3):LabourDay,
-		Date(2005, 5, 4):LabourDay,
-		Date(2005, 5, 5):LabourDay,
-		Date(2005, 5, 6):LabourDay,
-		Date(2005, 5, 7):LabourDay,
-		Date(2005, 10, 1):NationalDay,
-		Date(2005, 10, 2):NationalDay,
-		Date(2005, 10, 3):NationalDay,
-		Date(2005, 10, 4):NationalDay,
-		Date(2005, 10, 5):NationalDay,
-		Date(2005, 10, 6):NationalDay,
-		Date(2005, 10, 7):NationalDay,
-		Date(2006, 1, 1):NewYearsDay,
-		Date(2006, 1, 2):NewYearsDay,
-		Date(2006, 1, 3):NewYearsDay,
-		Date(2006, 1, 29):SpringFestival,
-		Date(2006, 1, 30):SpringFestival,
-		Date(2006, 1, 31):SpringFestival,
-		Date(2006, 2, 1):SpringFestival,
-		Date(2006, 2, 2):SpringFestival,
-		Date(2006, 2, 3):SpringFestival,
-		Date(2006, 2, 4):SpringFestival,
-		Date(2006, 5, 1):LabourDay,
-		Date(2006, 5, 2):LabourDay,
-		Date(2006, 5, 3):LabourDay,
-		Date(2006, 5, 4):LabourDay,
-		Date(2006, 5, 5):LabourDay,
-		Date(2006, 5, 6):LabourDay,
-		Date(2006, 5, 7):LabourDay,
-		Date(2006, 10, 1):NationalDay,
-		Date(2006, 10, 2):NationalDay,
-		Date(2006, 10, 3):NationalDay,
-		Date(2006, 10, 4):NationalDay,
-		Date(2006, 10, 5):NationalDay,
-		Date(2006, 10, 6):NationalDay,
-		Date(2006, 10, 7):NationalDay,
-		Date(2007, 1, 1):NewYearsDay,
-		Date(2007, 1, 2):NewYearsDay,
-		Date(2007, 1, 3):NewYearsDay,
-		Date(2007, 2, 18):SpringFestival,
-		Date(2007, 2, 19):SpringFestival,
-		Date(2007, 2, 20):SpringFestival,
-		Date(2007, 2, 21):SpringFestival,
-		Date(2007, 2, 22):SpringFestival,
-		Date(2007, 2, 23):SpringFestival,
-		Date(2007, 2, 24):SpringFestival,
-		Date(2007, 5, 1):LabourDay,
-		Date(2007, 5, 2):LabourDay,
-		Date(2007, 5, 3):LabourDay,
-		Date(2007, 5, 4):LabourDay,
-		Date(2007, 5, 5):LabourDay,
-		Date(2007, 5, 6):LabourDay,
-		Date(2007, 5, 7):LabourDay,
-		Date(2007, 10, 1):NationalDay,
-		Date(2007, 10, 2):NationalDay,
-		Date(2007, 10, 3):NationalDay,
-		Date(2007, 10, 4):NationalDay,
-		Date(2007, 10, 5):NationalDay,
-		Date(2007, 10, 6):NationalDay,
-		Date(2007, 10, 7):NationalDay,
-		Date(2007, 12, 30):NewYearsDay,
-		Date(2007, 12, 31):NewYearsDay,
-		Date(2008, 1, 1):NewYearsDay,
-		Date(2008, 2, 6):SpringFestival,
-		Date(2008, 2, 7):SpringFestival,
-		Date(2008, 2, 8):SpringFestival,
-		Date(2008, 2, 9):SpringFestival,
-		Date(2008, 2, 10):SpringFestival,
-		Date(2008, 2, 11):SpringFestival,
-		Date(2008, 2, 12):SpringFestival,
-		Date(2008, 4, 4):TombSweepingDay,
-		Date(2008, 4, 5):TombSweepingDay,
-		Date(2008, 4, 6):TombSweepingDay,
-		Date(2008, 5, 1):LabourDay,
-		Date(2008, 5, 2):LabourDay,
-		Date(2008, 5, 3):LabourDay,
-		Date(2008, 6, 7):DragonBoatFestival,
-		Date(2008, 6, 8):DragonBoatFestival,
-		Date(2008, 6, 9):DragonBoatFestival,
-		Date(2008, 9, 13):MidAutumnFestival,
-		Date(2008, 9, 14):MidAutumnFestival,
-		Date(2008, 9, 15):MidAutumnFestival,
-		Date(2008, 9, 29):NationalDay,
-		Date(2008, 9, 30):NationalDay,
-		Date(2008, 10, 1):NationalDay,
-		Date(2008, 10, 2):NationalDay,
-		Date(2008, 10, 3):NationalDay,
-		Date(2008, 10, 4):NationalDay,
-		Date(2008, 10, 5):NationalDay,
-		Date(2009, 1, 1):NewYearsDay,
-		Date(2009, 1, 2):NewYearsDay,
-		Date(2009, 1, 3):NewYearsDay,
-		Date(2009, 1, 25):SpringFestival,
-		Date(2009, 1, 26):SpringFestival,
-		Date(2009, 1, 27):SpringFestival,
-		Date(2009, 1, 28):SpringFestival,
-		Date(2009, 1, 29):SpringFestival,
-		Date(2009, 1, 30):SpringFestival,
-		Date(2009, 1, 31):SpringFestival,
-		Date(2009, 4, 4):TombSweepingDay,
-		Date(2009, 4, 5):TombSweepingDay,
-		Date(2009, 4, 6):TombSweepingDay,
-		Date(2009, 5, 1):LabourDay,
-		Date(2009, 5, 2):LabourDay,
-		Date(2009, 5, 3):LabourDay,
-		Date(2009, 5, 28):DragonBoatFestival,
-		Date(2009, 5, 29):DragonBoatFestival,
-		Date(2009, 5, 30):DragonBoatFestival,
-		Date(2009, 10, 1):NationalDay,
-		Date(2009, 10, 2):NationalDay,
-		Date(2009, 10, 3):MidAutumnFestival,
-		Date(2009, 10, 4):NationalDay,
-		Date(2009, 10, 5):NationalDay,
-		Date(2009, 10, 6):NationalDay,
-		Date(2009, 10, 7):NationalDay,
-		Date(2009, 10, 8):NationalDay,
-		Date(2010, 1, 1):NewYearsDay,
-		Date(2010, 1, 2):NewYearsDay,
-		Date(2010, 1, 3):NewYearsDay,
-		Date(2010, 2, 13):SpringFestival,
-		Date(2010, 2, 14):SpringFestival,
-		Date(2010, 2, 15):SpringFestival,
-		Date(2010, 2, 16):SpringFestival,
-		Date(2010, 2, 17):SpringFestival,
-		Date(2010, 2, 18):SpringFestival,
-		Date(2010, 2, 19):SpringFestival,
-		Date(2010, 4, 3):TombSweepingDay,
-		Date(2010, 4, 4):TombSweepingDay,
-		Date(2010, 4, 5):TombSweepingDay,
-		Date(2010, 5, 1):LabourDay,
-		Date(2010, 5, 2):LabourDay,
-		Date(2010, 5, 3):LabourDay,
-		Date(2010, 6, 14):DragonBoatFestival,
-		Date(2010, 6, 15):DragonBoatFestival,
-		Date(2010, 6, 16):DragonBoatFestival,
-		Date(2010, 9, 22):MidAutumnFestival,
-		Date(2010, 9, 23):MidAutumnFestival,
-		Date(2010, 9, 24):MidAutumnFestival,
-		Date(2010, 10, 1):NationalDay,
-		Date(2010, 10, 2):NationalDay,
-		Date(2010, 10, 3):NationalDay,
-		Date(2010, 10, 4):NationalDay,
-		Date(2010, 10, 5):NationalDay,
-		Date(2010, 10, 6):NationalDay,
-		Date(2010, 10, 7):NationalDay,
-		Date(2011, 1, 1):NewYearsDay,
-		Date(2011, 1, 2):NewYearsDay,
-		Date(2011, 1, 3):NewYearsDay,
-		Date(2011, 2, 2):SpringFestival,
-		Date(2011, 2, 3):SpringFestival,
-		Date(2011, 2, 4):SpringFestival,
-		Date(2011, 2, 5):SpringFestival,
-		Date(2011, 2, 6):SpringFestival,
-		Date(2011, 2, 7):SpringFestival,
-		Date(2011, 2, 8):SpringFestival,
-		Date(2011, 4, 3):TombSweepingDay,
-		Date(2011, 4, 4):TombSweepingDay,
-		Date(2011, 4, 5):TombSweepingDay,
-		Date(2011, 4, 30):LabourDay,
-		Date(2011, 5, 1):LabourDay,
-		Date(2011, 5, 2):LabourDay,
-		Date(2011, 6, 4):DragonBoatFestival,
-		Date(2011, 6, 6):DragonBoatFestival,
-		Date(2011, 9, 10):MidAutumnFestival,
-		Date(2011, 9, 11):MidAutumnFestival,
-		Date(2011, 9, 12):MidAutumnFestival,
-		Date(2011, 10, 1):NationalDay,
-		Date(2011, 10, 2):NationalDay,
-		Date(2011, 10, 3):NationalDay,
-		Date(2011, 10, 4):NationalDay,
-		Date(2011, 10, 5):NationalDay,
-		Date(2011, 10, 6):NationalDay,
-		Date(2011, 10, 7):NationalDay,
-		Date(2012, 1, 1):NewYearsDay,
-		Date(2012, 1, 2):NewYearsDay,
-		Date(2012, 1, 3):NewYearsDay,
-		Date(2012, 1, 22):SpringFestival,
-		Date(2012, 1, 23):SpringFestival,
-		Date(2012, 1, 24):SpringFestival,
-		Date(2012, 1, 25):SpringFestival,
-		Date(2012, 1, 26):SpringFestival,
-		Date(2012, 1, 27):SpringFestival,
-		Date(2012, 1, 28):SpringFestival,
-		Date(2012, 4, 2):TombSweepingDay,
-		Date(2012, 4, 3):TombSweepingDay,
-		Date(2012, 4, 4):TombSweepingDay,
-		Date(2012, 4, 29):LabourDay,
-		Date(2012, 4, 30):LabourDay,
-		Date(2012, 5, 1):LabourDay,
-		Date(2012, 6, 22):DragonBoatFestival,
-		Date(2012, 6, 24):DragonBoatFestival,
-		Date(2012, 9, 30):MidAutumnFestival,
-		Date(2012, 10, 1):NationalDay,
-		Date(2012, 10, 2):NationalDay,
-		Date(2012, 10, 3):NationalDay,
-		Date(2012, 10, 4):NationalDay,
-		Date(2012, 10, 5):NationalDay,
-		Date(2012, 10, 6):NationalDay,
-		Date(2012, 10, 7):NationalDay,
-		Date(2013, 1, 1):NewYearsDay,
-		Date(2013, 1, 2):NewYearsDay,
-		Date(2013, 1, 3):NewYearsDay,
-		Date(2013, 2, 9):SpringFestival,
-		Date(2013, 2, 10):SpringFestival,
-		Date(2013, 2, 11):SpringFestival,
-		Date(2013, 2, 12):SpringFestival,
-		Date(2013, 2, 13):SpringFestival,
-		Date(2013, 2, 14):SpringFestival,
-		Date(2013, 2, 15):SpringFestival,
-		Date(2013, 4, 4):TombSweepingDay,
-		Date(2013, 4, 5):TombSweepingDay,
-		Date(2013, 4, 6):TombSweepingDay,
-		Date(2013, 4, 29):LabourDay,
-		Date(2013, 4, 30):LabourDay,
-		Date(2013, 5, 1):LabourDay,
-		Date(2013, 6, 10):DragonBoatFestival,
-		Date(2013, 6, 11):DragonBoatFestival,
-		Date(2013, 6, 12):DragonBoatFestival,
-		Date(2013, 9, 19):MidAutumnFestival,
-		Date(2013, 9, 20):MidAutumnFestival,
-		Date(2013, 9, 21):MidAutumnFestival,
-		Date(2013, 10, 1):NationalDay,
-		Date(2013, 10, 2):NationalDay,
-		Date(2013, 10, 3):NationalDay,
-		Date(2013, 10, 4):NationalDay,
-		Date(2013, 10, 5):NationalDay,
-		Date(2013, 10, 6):NationalDay,
-		Date(2013, 10, 7):NationalDay,
-		Date(2014, 1, 1):NewYearsDay,
-		Date(2014, 1, 31):SpringFestival,
-		Date(2014, 2, 1):SpringFestival,
-		Date(2014, 2, 2):SpringFestival,
-		Date(2014, 2, 3):SpringFestival,
-		Date(2014, 2, 4):SpringFestival,
-		Date(2014, 2, 5):SpringFestival,
-		Date(2014, 2, 6):SpringFestival,
-		Date(2014, 4, 5):TombSweepingDay,
-		Date(2014, 4, 6):TombSweepingDay,
-		Date(2014, 4, 7):TombSweepingDay,
-		Date(2014, 5, 1):LabourDay,
-		Date(2014, 5, 2):LabourDay,
-		Date(2014, 5, 3):LabourDay,
-		Date(2014, 6, 2):DragonBoatFestival,
-		Date(2014, 9, 8):MidAutumnFestival,
-		Date(2014, 10, 1):NationalDay,
-		Date(2014, 10, 2):NationalDay,
-		Date(2014, 10, 3):NationalDay,
-		Date(2014, 10, 4):NationalDay,
-		Date(2014, 10, 5):NationalDay,
-		Date(2014, 10, 6):NationalDay,
-		Date(2014, 10, 7):NationalDay,
-		Date(2015, 1, 1):NewYearsDay,
-		Date(2015, 1, 2):NewYearsDay,
-		Date(2015, 1, 3):NewYearsDay,
-		Date(2015, 2, 18):SpringFestival,
-		Date(2015, 2, 19):SpringFestival,
-		Date(2015, 2, 20):SpringFestival,
-		Date(2015, 2, 21):SpringFestival,
-		Date(2015, 2, 22):SpringFestival,
-		Date(2015, 2, 23):SpringFestival,
-		Date(2015, 2, 24):SpringFestival,
-		Date(2015, 4, 5):TombSweepingDay,
-		Date(2015, 4, 6):TombSweepingDay,
-		Date(2015, 5, 1):LabourDay,
-		Date(2015, 6, 20):DragonBoatFestival,
-		Date(2015, 6, 22):DragonBoatFestival,
-		Date(2015, 9, 3):NationalDay,
-		Date(2015, 9, 4):NationalDay,
-		Date(2015, 9, 27):MidAutumnFestival,
-		Date(2015, 10, 1):NationalDay,
-		Date(2015, 10, 2):NationalDay,
-		Date(2015, 10, 3):NationalDay,
-		Date(2015, 10, 4):NationalDay,
-		Date(2015, 10, 5):NationalDay,
-		Date(2015, 10, 6):NationalDay,
-		Date(2015, 10, 7):NationalDay,
-		Date(2017, 1, 1):NewYearsDay,
-		Date(2017, 1, 2):NewYearsDay,
-		Date(2017, 1, 27):SpringFestival,
-		Date(2017, 1, 28):SpringFestival,
-		Date(2017, 1, 29):SpringFestival,
-		Date(2017, 1, 30):SpringFestival,
-		Date(2017, 1, 31):SpringFestival,
-		Date(2017, 2, 1):SpringFestival,
-		Date(2017, 2, 2):SpringFestival,
-		Date(2017, 2, 7):SpringFestival,
-		Date(2017, 2, 8):SpringFestival,
-		Date(2017, 2, 9):SpringFestival,
-		Date(2017, 2, 10):SpringFestival,
-		Date(2017, 2, 11):SpringFestival,
-		Date(2017, 2, 12):SpringFestival,
-		Date(2017, 2, 13):SpringFestival,
-		Date(2017, 4, 2):TombSweepingDay,
-		Date(2017, 4, 3):TombSweepingDay,
-		Date(2017, 4, 4):TombSweepingDay,
-		Date(2017, 5, 1):LabourDay,
-		Date(2017, 5, 2):LabourDay,
-		Date(2017, 5, 28):DragonBoatFestival,
-		Date(2017, 5, 29):DragonBoatFestival,
-		Date(2017, 5, 30):DragonBoatFestival,
-		Date(2017, 6, 9):DragonBoatFestival,
-		Date(2017, 6, 10):DragonBoatFestival,
-		Date(2017, 6, 11):DragonBoatFestival,
-		Date(2017, 9, 15):MidAutumnFestival,
-		Date(2017, 9, 16):MidAutumnFestival,
-		Date(2017, 9, 17):MidAutumnFestival,
-		Date(2017, 10, 1):NationalDay,
-		Date(2017, 10, 2):NationalDay,
-		Date(2017, 10, 3):NationalDay,
-		Date(2017, 10, 4):MidAutumnFestival,
-		Date(2017, 10, 5):NationalDay,
-		Date(2017, 10, 6):NationalDay,
-		Date(2017, 10, 7):NationalDay,
-		Date(2017, 10, 8):NationalDay,
-		Date(2018, 1, 1):NewYearsDay,
-		Date(2018, 2, 15):SpringFestival,
-		Date(2018, 2, 16):SpringFestival,
-		Date(2018, 2, 17):SpringFestival,
-		Date(2018, 2, 18):SpringFestival,
-		Date(2018, 2, 19):SpringFestival,
-		Date(2018, 2, 20):SpringFestival,
-		Date(2018, 2, 21):SpringFestival,
-		Date(2018, 4, 5):TombSweepingDay,
-		Date(2018, 4, 6):TombSweepingDay,
-		Date(2018, 4, 7):TombSweepingDay,
-		Date(2018, 4, 29):LabourDay,
-		Date(2018, 4, 30):LabourDay,
-		Date(2018, 5, 1):LabourDay,
-		Date(2018, 6, 18):DragonBoatFestival,
-		Date(2018, 9, 24):MidAutumnFestival,
-		Date(2018, 10, 1):NationalDay,
-		Date(2018, 10, 2):NationalDay,
-		Date(2018, 10, 3):NationalDay,
-		Date(2018, 10, 4):NationalDay,
-		Date(2018, 10, 5):NationalDay,
-		Date(2018, 10, 6):NationalDay,
-		Date(2018, 10, 7):NationalDay,
-		Date(2018, 12, 30):NewYearsDay,
-		Date(2018, 12, 31):NewYearsDay,
-		Date(2019, 1, 1):NewYearsDay,
-		Date(2019, 2, 4):SpringFestival,
-		Date(2019, 2, 5):SpringFestival,
-		Date(2019, 2, 6):SpringFestival,
-		Date(2019, 2, 7):SpringFestival,
-		Date(2019, 2, 8):SpringFestival,
-		Date(2019, 2, 9):SpringFestival,
-		Date(2019, 2, 10):SpringFestival,
-		Date(2019, 4, 5):TombSweepingDay,
-		Date(2019, 4, 6):TombSweepingDay,
-		Date(2019, 4, 7):TombSweepingDay,
-		Date(2019, 5, 1):LabourDay,
-		Date(2019, 5, 2):LabourDay,
-		Date(2019, 5, 3):LabourDay,
-		Date(2019, 5, 4):LabourDay,
-		Date(2019, 6, 7):DragonBoatFestival,
-		Date(2019, 6, 8):DragonBoatFestival,
-		Date(2019, 6, 9):DragonBoatFestival,
-		Date(2019, 9, 13):MidAutumnFestival,
-		Date(2019, 9, 14):MidAutumnFestival,
-		Date(2019, 9, 15):MidAutumnFestival,
-		Date(2019, 10, 1):NationalDay,
-		Date(2019, 10, 2):NationalDay,
-		Date(2019, 10, 3):NationalDay,
-		Date(2019, 10, 4):NationalDay,
-		Date(2019, 10, 5):NationalDay,
-		Date(2019, 10, 6):NationalDay,
-		Date(2019, 10, 7):NationalDay,
-		Date(2020, 1, 1):NewYearsDay,
-		Date(2020, 1, 24):SpringFestival,
-		Date(2020, 1, 25):SpringFestival,
-		Date(2020, 1, 26):SpringFestival,
-		Date(2020, 1, 27):SpringFestival,
-		Date(2020, 1, 28):SpringFestival,
-		Date(2020, 1, 29):SpringFestival,
-		Date(2020, 1, 30):SpringFestival,
-		Date(2020, 1, 31):SpringFestival,
-		Date(2020, 2, 1):SpringFestival,
-		Date(2020, 2, 2):SpringFestival,
-		Date(2020, 4, 4):TombSweepingDay,
-		Date(2020, 4, 5):TombSweepingDay,
-		Date(2020, 4, 6):TombSweepingDay,
-		Date(2020, 5, 1):LabourDay,
-		Date(2020, 5, 2):LabourDay,
-		Date(2020, 5, 3):LabourDay,
-		Date(2020, 5, 4):LabourDay,
-		Date(2020, 5, 5):LabourDay,
-		Date(2020, 6, 25):DragonBoatFestival,
-		Date(2020, 6, 26):DragonBoatFestival,
-		Date(2020, 6, 27):DragonBoatFestival,
-		Date(2020, 10, 1):NationalDay,
-		Date(2020, 10, 2):NationalDay,
-		Date(2020, 10, 3):NationalDay,
-		Date(2020, 10, 4):NationalDay,
-		Date(2020, 10, 5):NationalDay,
-		Date(2020, 10, 6):NationalDay,
-		Date(2020, 10, 7):NationalDay,
-		Date(2020, 10, 8):NationalDay,
-		Date(2021, 1, 1):NewYearsDay,
-		Date(2021, 1, 2):NewYearsDay,
-		Date(2021, 1, 3):NewYearsDay,
-		Date(2021, 2, 11):SpringFestival,
-		Date(2021, 2, 12):SpringFestival,
-		Date(2021, 2, 13):SpringFestival,
-		Date(2021, 2, 14):SpringFestival,
-		Date(2021, 2, 15):SpringFestival,
-		Date(2021, 2, 16):SpringFestival,
-		Date(2021, 2, 17):SpringFestival,
-		Date(2021, 4, 3):TombSweepingDay,
-		Date(2021, 4, 4):TombSweepingDay,
-		Date(2021, 4, 5):TombSweepingDay,
-		Date(2021, 5, 1):LabourDay,
-		Date(2021, 5, 2):LabourDay,
-		Date(2021, 5, 3):LabourDay,
-		Date(2021, 5, 4):LabourDay,
-		Date(2021, 5, 5):LabourDay,
-		Date(2021, 6, 12):DragonBoatFestival,
-		Date(2021, 6, 13):DragonBoatFestival,
-		Date(2021, 6, 14):DragonBoatFestival,
-		Date(2021, 9, 19):MidAutumnFestival,
-		Date(2021, 9, 20):MidAutumnFestival,
-		Date(2021, 9, 21):MidAutumnFestival,
-		Date(2021, 10, 1):NationalDay,
-		Date(2021, 10, 2):NationalDay,
-		Date(2021, 10, 3):NationalDay,
-		Date(2021, 10, 4):NationalDay,
-		Date(2021, 10, 5):NationalDay,
-		Date(2021, 10, 6):NationalDay,
-		Date(2021, 10, 7):NationalDay,
-		Date(2022, 1, 1):NewYearsDay,
-		Date(2022, 1, 2):NewYearsDay,
-		Date(2022, 1, 3):NewYearsDay,
-		Date(2022, 1, 31):SpringFestival,
-		Date(2022, 2, 1):SpringFestival,
-		Date(2022, 2, 2):SpringFestival,
-		Date(2022, 2, 3):SpringFestival,
-		Date(2022, 2, 4):SpringFestival,
-		Date(2022, 2, 5):SpringFestival,
-		Date(2022, 2, 6):SpringFestival,
-		Date(2022, 4, 3):TombSweepingDay,
-		Date(2022, 4, 4):TombSweepingDay,
-		Date(2022, 4, 5):TombSweepingDay,
-		Date(2022, 4, 30):LabourDay,
-		Date(2022, 5, 1):LabourDay,
-		Date(2022, 5, 2):LabourDay,
-		Date(2022, 5, 3):LabourDay,
-		Date(2022, 5, 4):LabourDay,
-		Date(2022, 6, 3):DragonBoatFestival,
-		Date(2022, 6, 4):DragonBoatFestival,
-		Date(2022, 6, 5):DragonBoatFestival,
-		Date(2022, 9, 10):MidAutumnFestival,
-		Date(2022, 9, 11):MidAutumnFestival,
-		Date(2022, 9, 12):MidAutumnFestival,
-		Date(2022, 10, 1):NationalDay,
-		Date(2022, 10, 2):NationalDay,
-		Date(2022, 10, 3):NationalDay,
-		Date(2022, 10, 4):NationalDay,
-		Date(2022, 10, 5):NationalDay,
-		Date(2022, 10, 6):NationalDay,
-		Date(2022, 10, 7):NationalDay,
-		Date(2022, 12, 31):NewYearsDay,
-		Date(2023, 1, 1):NewYearsDay,
-		Date(2023, 1, 2):NewYearsDay,
-		Date(2023, 1, 21):SpringFestival,
-		Date(2023, 1, 22):SpringFestival,
-		Date(2023, 1, 23):SpringFestival,
-		Date(2023, 1, 24):SpringFestival,
-		Date(2023, 1, 25):SpringFestival,
-		Date(2023, 1, 26):SpringFestival,
-		Date(2023, 1, 27):SpringFestival,
-		Date(2023, 4, 5):TombSweepingDay,
-		Date(2023, 4, 29):LabourDay,
-		Date(2023, 4, 30):LabourDay,
-		Date(2023, 5, 1):LabourDay,
-		Date(2023, 5, 2):LabourDay,
-		Date(2023, 5, 3):LabourDay,
-		Date(2023, 6, 22):DragonBoatFestival,
-		Date(2023, 6, 23):DragonBoatFestival,
-		Date(2023, 6, 24):DragonBoatFestival,
-		Date(2023, 9, 29):MidAutumnFestival,
-		Date(2023, 9, 30):NationalDay,
-		Date(2023, 10, 1):NationalDay,
-		Date(2023, 10, 2):NationalDay,
-		Date(2023, 10, 3):NationalDay,
-		Date(2023, 10, 4):NationalDay,
-		Date(2023, 10, 5):NationalDay,
-		Date(2023, 10, 6):NationalDay,
-		Date(2024, 1, 1):NewYearsDay,
-		Date(2024, 2, 10):SpringFestival,
-		Date(2024, 2, 11):SpringFestival,
-		Date(2024, 2, 12):SpringFestival,
-		Date(2024, 2, 13):SpringFestival,
-		Date(2024, 2, 14):SpringFestival,
-		Date(2024, 2, 15):SpringFestival,
-		Date(2024, 2, 16):SpringFestival,
-		Date(2024, 2, 17):SpringFestival,
-		Date(2024, 4, 4):TombSweepingDay,
-		Date(2024, 4, 5):TombSweepingDay,
-		Date(2024, 4, 6):TombSweepingDay,
-		Date(2024, 5, 1):LabourDay,
-		Date(2024, 5, 2):LabourDay,
-		Date(2024, 5, 3):LabourDay,
-		Date(2024, 5, 4):LabourDay,
-		Date(2024, 5, 5):LabourDay,
-		Date(2024, 6, 10):DragonBoatFestival,
-		Date(2024, 9, 15):MidAutumnFestival,
-		Date(2024, 9, 16):MidAutumnFestival,
-		Date(2024, 9, 17):MidAutumnFestival,
-		Date(2024, 10, 1):NationalDay,
-		Date(2024, 10, 2):NationalDay,
-		Date(2024, 10, 3):NationalDay,
-		Date(2024, 10, 4):NationalDay,
-		Date(2024, 10, 5):NationalDay,
-		Date(2024, 10, 6):NationalDay,
-		Date(2024, 10, 7):NationalDay,
-		
-	}
-
-	// 工作日
-	workdays = map[time.Time]Holiday{
-		Date(2004, 1, 17):SpringFestival,
-		Date(2004, 1, 18):SpringFestival,
-		Date(2004, 5, 8):LabourDay,
-		Date(2004, 5, 9):LabourDay,
-		Date(2004, 10, 9):NationalDay,
-		Date(2004, 10, 10):NationalDay,
-		Date(2005, 2, 5):SpringFestival,
-		Date(2005, 2, 6):SpringFestival,
-		Date(2005, 4, 30):LabourDay,
-		Date(2005, 5, 8):LabourDay,
-		Date(2005, 10, 8):NationalDay,
-		Date(2005, 10, 9):NationalDay,
-		Date(2006, 1, 28):SpringFestival,
-		Date(2006, 2, 5):SpringFestival,
-		Date(2006, 4, 29):LabourDay,
-		Date(2006, 4, 30):LabourDay,
-		Date(2006, 9, 30):NationalDay,
-		Date(2006, 10, 8):NationalDay,
-		Date(2006, 12, 30):NewYearsDay,
-		Date(2006, 12, 31):NewYearsDay,
-		Date(2007, 2, 17):SpringFestival,
-		Date(2007, 2, 25):SpringFestival,
-		Date(2007, 4, 28):LabourDay,
-		Date(2007, 4, 29):LabourDay,
-		Date(2007, 9, 29):NationalDay,
-		Date(2007, 9, 30):NationalDay,
-		Date(2007, 12, 29):NewYearsDay,
-		Date(2008, 2, 2):SpringFestival,
-		Date(2008, 2, 3):SpringFestival,
-		Date(2008, 5, 4):LabourDay,
-		Date(2008, 9, 27):NationalDay,
-		Date(2008, 9, 28):NationalDay,
-		Date(2009, 1, 4):NewYearsDay,
-		Date(2009, 1, 24):SpringFestival,
-		Date(2009, 2, 1):SpringFestival,
-		Date(2009, 5, 31):DragonBoatFestival,
-		Date(2009, 9, 27):NationalDay,
-		Date(2009, 10, 10):NationalDay,
-		Date(2010, 2, 20):SpringFestival,
-		Date(2010, 2, 21):SpringFestival,
-		Date(2010, 6, 12):DragonBoatFestival,
-		Date(2010, 6, 13):DragonBoatFestival,
-		Date(2010, 9, 19):MidAutumnFestival,
-		Date(2010, 9, 25):MidAutumnFestival,
-		Date(2010, 9, 26):NationalDay,
-		Date(2010, 10, 9):NationalDay,
-		Date(2011, 1, 30):SpringFestival,
-		Date(2011, 2, 12):SpringFestival,
-		Date(2011, 4, 2):TombSweepingDay,
-		Date(2011, 10, 8):NationalDay,
-		Date(2011, 10, 9):NationalDay,
-		Date(2011, 12, 31):NewYearsDay,
-		Date(2012, 1, 21):SpringFestival,
-		Date(2012, 1, 29):SpringFestival,
-		Date(2012, 3, 31):TombSweepingDay,
-		Date(2012, 4, 1):TombSweepingDay,
-		Date(2012, 4, 28):LabourDay,
-		Date(2012, 9, 29):NationalDay,
-		Date(2013, 1, 5):NewYearsDay,
-		Date(2013, 1, 6):NewYearsDay,
-		Date(2013, 2, 16):SpringFestival,
-		Date(2013, 2, 17):SpringFestival,
-		Date(2013, 4, 7):TombSweepingDay,
-		Date(2013, 4, 27):LabourDay,
-		Date(2013, 4, 28):LabourDay,
-		Date(2013, 6, 8):DragonBoatFestival,
-		Date(2013, 6, 9):DragonBoatFestival,
-		Date(2013, 9, 22):MidAutumnFestival,
-		Date(2013, 9, 29):NationalDay,
-		Date(2013, 10, 12):NationalDay,
-		Date(2014, 1, 26):SpringFestival,
-		Date(2014, 2, 8):SpringFestival,
-		Date(2014, 5, 4):LabourDay,
-		Date(2014, 9, 28):NationalDay,
-		Date(2014, 10, 11):NationalDay,
-		Date(2015, 1, 4):NewYearsDay,
-		Date(2015, 2, 15):SpringFestival,
-		Date(2015, 2, 28):SpringFestival,
-		Date(2015, 9, 6):NationalDay,
-		Date(2015, 10, 10):NationalDay,
-		Date(2017, 1, 22):SpringFestival,
-		Date(2017, 2, 4):SpringFestival,
-		Date(2017, 2, 6):SpringFestival,
-		Date(2017, 2, 14):SpringFestival,
-		Date(2017, 4, 1):TombSweepingDay,
-		Date(2017, 5, 27):DragonBoatFestival,
-		Date(2017, 6, 12):DragonBoatFestival,
-		Date(2017, 9, 18):MidAutumnFestival,
-		Date(2017, 9, 30):NationalDay,
-		Date(2017, 10, 8):NationalDay,
-		Date(2017, 10, 9):NationalDay,
-		Date(2018, 2, 11):SpringFestival,
-		Date(2018, 2, 24):SpringFestival,
-		Date(2018, 4, 8):TombSweepingDay,
-		Date(2018, 4, 28):LabourDay,
-		Date(2018, 9, 29):NationalDay,
-		Date(2018, 9, 30):NationalDay,
-		Date(2018, 12, 29):NewYearsDay,
-		Date(2019, 2, 2):SpringFestival,
-		Date(2019, 2, 3):SpringFestival,
-		Date(2019, 4, 28):LabourDay,
-		Date(2019, 5, 5):LabourDay,
-		Date(2019, 9, 29):NationalDay,
-		Date(2019, 10, 12):NationalDay,
-		Date(2020, 1, 19):SpringFestival,
-		Date(2020, 4, 26):LabourDay,
-		Date(2020, 5, 9):LabourDay,
-		Date(2020, 6, 28):DragonBoatFestival,
-		Date(2020, 9, 27):NationalDay,
-		Date(2020, 10, 10):NationalDay,
-		Date(2021, 2, 7):SpringFestival,
-		Date(2021, 2, 20):SpringFestival,
-		Date(2021, 4, 25):LabourDay,
-		Date(2021, 5, 8):LabourDay,
-		Date(2021, 9, 18):MidAutumnFestival,
-		Date(2021, 9, 26):NationalDay,
-		Date(2021, 10, 9):NationalDay,
-		Date(2022, 1, 29):SpringFestival,
-		Date(2022, 1, 30):SpringFestival,
-		Date(2022, 4, 2):TombSweepingDay,
-		Date(2022, 4, 24):LabourDay,
-		Date(2022, 5, 7):LabourDay,
-		Date(2022, 10, 8):NationalDay,
-		Date(2022, 10, 9):NationalDay,
-		Date(2023, 1, 28):SpringFestival,
-		Date(2023, 1, 29):SpringFestival,
-		Date(2023, 4, 23):LabourDay,
-		Date(2023, 5, 6):LabourDay,
-		Date(2023, 6, 25):DragonBoatFestival,
-		Date(2023, 10, 7):NationalDay,
-		Date(2023, 10, 8):NationalDay,
-		Date(2024, 2, 4):SpringFestival,
-		Date(2024, 2, 18):SpringFestival,
-		Date(2024, 4, 7):TombSweepingDay,
-		Date(2024, 4, 28):LabourDay,
-		Date(2024, 5, 11):LabourDay,
-		Date(2024, 9, 14):MidAutumnFestival,
-		Date(2024, 9, 29):NationalDay,
-		Date(2024, 10, 12):NationalDay,
-		
-	}
+	minDay = civilDate{2004, 1, 1}
+	maxDay = civilDate{2024, 10, 12}
 
-	// 替代日
-	inLieuDays = map[time.Time]Holiday{
-		Date(2004, 1, 27):SpringFestival,
-		Date(2004, 1, 28):SpringFestival,
-		Date(2004, 5, 6):LabourDay,
-		Date(2004, 5, 7):LabourDay,
-		Date(2004, 10, 6):NationalDay,
-		Date(2004, 10, 7):NationalDay,
-		Date(2005, 2, 14):SpringFestival,
-		Date(2005, 2, 15):SpringFestival,
-		Date(2005, 5, 5):LabourDay,
-		Date(2005, 5, 6):LabourDay,
-		Date(2005, 10, 6):NationalDay,
-		Date(2005, 10, 7):NationalDay,
-		Date(2006, 2, 2):SpringFestival,
-		Date(2006, 2, 3):SpringFestival,
-		Date(2006, 5, 4):LabourDay,
-		Date(2006, 5, 5):LabourDay,
-		Date(2006, 10, 5):NationalDay,
-		Date(2006, 10, 6):NationalDay,
-		Date(2007, 1, 2):NewYearsDay,
-		Date(2007, 1, 3):NewYearsDay,
-		Date(2007, 2, 22):SpringFestival,
-		Date(2007, 2, 23):SpringFestival,
-		Date(2007, 5, 4):LabourDay,
-		Date(2007, 5, 7):LabourDay,
-		Date(2007, 10, 4):NationalDay,
-		Date(2007, 10, 5):NationalDay,
-		Date(2007, 12, 31):NewYearsDay,
-		Date(2008, 2, 11):SpringFestival,
-		Date(2008, 2, 12):SpringFestival,
-		Date(2008, 5, 2):LabourDay,
-		Date(2008, 9, 29):NationalDay,
-		Date(2008, 9, 30):NationalDay,
-		Date(2009, 1, 2):NewYearsDay,
-		Date(2009, 1, 29):SpringFestival,
-		Date(2009, 1, 30):SpringFestival,
-		Date(2009, 5, 29):DragonBoatFestival,
-		Date(2009, 10, 7):NationalDay,
-		Date(2009, 10, 8):NationalDay,
-		Date(2010, 2, 18):SpringFestival,
-		Date(2010, 2, 19):SpringFestival,
-		Date(2010, 6, 14):DragonBoatFestival,
-		Date(2010, 6, 15):DragonBoatFestival,
-		Date(2010, 9, 23):MidAutumnFestival,
-		Date(2010, 9, 24):MidAutumnFestival,
-		Date(2010, 10, 6):NationalDay,
-		Date(2010, 10, 7):NationalDay,
-		Date(2011, 2, 7):SpringFestival,
-		Date(2011, 2, 8):SpringFestival,
-		Date(2011, 4, 4):TombSweepingDay,
-		Date(2011, 10, 6):NationalDay,
-		Date(2011, 10, 7):NationalDay,
-		Date(2012, 1, 3):NewYearsDay,
-		Date(2012, 1, 26):SpringFestival,
-		Date(2012, 1, 27):SpringFestival,
-		Date(2012, 4, 2):TombSweepingDay,
-		Date(2012, 4, 3):TombSweepingDay,
-		Date(2012, 4, 30):LabourDay,
-		Date(2012, 10, 5):NationalDay,
-		Date(2013, 1, 2):NewYearsDay,
-		Date(2013, 1, 3):NewYearsDay,
-		Date(2013, 2, 14):SpringFestival,
-		Date(2013, 2, 15):SpringFestival,
-		Date(2013, 4, 5):TombSweepingDay,
-		Date(2013, 4, 29):LabourDay,
-		Date(2013, 4, 30):LabourDay,
-		Date(2013, 6, 10):DragonBoatFestival,
-		Date(2013, 6, 11):DragonBoatFestival,
-		Date(2013, 9, 20):MidAutumnFestival,
-		Date(2013, 10, 4):NationalDay,
-		Date(2013, 10, 7):NationalDay,
-		Date(2014, 2, 5):SpringFestival,
-		Date(2014, 2, 6):SpringFestival,
-		Date(2014, 5, 2):LabourDay,
-		Date(2014, 10, 6):NationalDay,
-		Date(2014, 10, 7):NationalDay,
-		Date(2015, 1, 2):NewYearsDay,
-		Date(2015, 2, 23):SpringFestival,
-		Date(2015, 2, 24):SpringFestival,
-		Date(2015, 9, 4):NationalDay,
-		Date(2015, 10, 7):NationalDay,
-		Date(2017, 2, 1):SpringFestival,
-		Date(2017, 2, 2):SpringFestival,
-		Date(2017, 2, 11):SpringFestival,
-		Date(2017, 2, 12):SpringFestival,
-		Date(2017, 4, 3):TombSweepingDay,
-		Date(2017, 5, 29):DragonBoatFestival,
-		Date(2017, 6, 10):DragonBoatFestival,
-		Date(2017, 9, 16):MidAutumnFestival,
-		Date(2017, 10, 6):NationalDay,
-		Date(2017, 10, 7):NationalDay,
-		Date(2018, 2, 19):SpringFestival,
-		Date(2018, 2, 20):SpringFestival,
-		Date(2018, 2, 21):SpringFestival,
-		Date(2018, 4, 6):TombSweepingDay,
-		Date(2018, 4, 30):LabourDay,
-		Date(2018, 10, 4):NationalDay,
-		Date(2018, 10, 5):NationalDay,
-		Date(2018, 12, 31):NewYearsDay,
-		Date(2019, 2, 4):SpringFestival,
-		Date(2019, 2, 8):SpringFestival,
-		Date(2019, 5, 2):LabourDay,
-		Date(2019, 5, 3):LabourDay,
-		Date(2019, 10, 4):NationalDay,
-		Date(2019, 10, 7):NationalDay,
-		Date(2020, 1, 29):SpringFestival,
-		Date(2020, 5, 4):LabourDay,
-		Date(2020, 5, 5):LabourDay,
-		Date(2020, 6, 26):DragonBoatFestival,
-		Date(2020, 10, 7):NationalDay,
-		Date(2020, 10, 8):NationalDay,
-		Date(2021, 2, 16):SpringFestival,
-		Date(2021, 2, 17):SpringFestival,
-		Date(2021, 5, 4):LabourDay,
-		Date(2021, 5, 5):LabourDay,
-		Date(2021, 9, 20):MidAutumnFestival,
-		Date(2021, 10, 6):NationalDay,
-		Date(2021, 10, 7):NationalDay,
-		Date(2022, 2, 3):SpringFestival,
-		Date(2022, 2, 4):SpringFestival,
-		Date(2022, 4, 4):TombSweepingDay,
-		Date(2022, 5, 3):LabourDay,
-		Date(2022, 5, 4):LabourDay,
-		Date(2022, 10, 6):NationalDay,
-		Date(2022, 10, 7):NationalDay,
-		Date(2023, 1, 26):SpringFestival,
-		Date(2023, 1, 27):SpringFestival,
-		Date(2023, 5, 2):LabourDay,
-		Date(2023, 5, 3):LabourDay,
-		Date(2023, 6, 23):DragonBoatFestival,
-		Date(2023, 10, 5):NationalDay,
-		Date(2023, 10, 6):NationalDay,
-		Date(2024, 2, 15):SpringFestival,
-		Date(2024, 2, 16):SpringFestival,
-		Date(2024, 4, 5):TombSweepingDay,
-		Date(2024, 5, 2):LabourDay,
-		Date(2024, 5, 3):LabourDay,
-		Date(2024, 9, 17):MidAutumnFestival,
-		Date(2024, 10, 4):NationalDay,
-		Date(2024, 10, 7):NationalDay,
-		
+	// 按年份排列的节假日位图：holidays 放假日，workdays 调休上班日，inLieuDays 替代日，statutory 法定假日
+	yearTables = []yearTable{
+		{
+			year:       2004,
+			holidays:   dayBits{0x000000000fe00001, 0xfe00000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000001fc0000, 0x0000000000000000},
+			workdays:   dayBits{0x0000000000030000, 0x0000000000000000, 0x0000000000000003, 0x0000000000000000, 0x000000000c000000, 0x0000000000000000},
+			inLieuDays: dayBits{0x000000000c000000, 0xc000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000001800000, 0x0000000000000000},
+			statutory:  dayBits{0x0000000000e00001, 0x0e00000000000000, 0x0000000000000000, 0x0000000000000000, 0x00000000001c0000, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 0, NewYearsDay},
+				{16, 17, SpringFestival},
+				{21, 27, SpringFestival},
+				{121, 129, LabourDay},
+				{274, 280, NationalDay},
+				{282, 283, NationalDay},
+			},
+		},
+		{
+			year:       2005,
+			holidays:   dayBits{0x00003f8000000007, 0x7f00000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000fe0000, 0x0000000000000000},
+			workdays:   dayBits{0x0000001800000000, 0x8080000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000003000000, 0x0000000000000000},
+			inLieuDays: dayBits{0x0000300000000000, 0x3000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000c00000, 0x0000000000000000},
+			statutory:  dayBits{0x0000038000000001, 0x0700000000000000, 0x0000000000000000, 0x0000000000000000, 0x00000000000e0000, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 2, NewYearsDay},
+				{35, 36, SpringFestival},
+				{39, 45, SpringFestival},
+				{119, 127, LabourDay},
+				{273, 281, NationalDay},
+			},
+		},
+		{
+			year:       2006,
+			holidays:   dayBits{0x00000007f0000007, 0x7f00000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000fe0000, 0x0000000000000000},
+			workdays:   dayBits{0x0000000808000000, 0x00c0000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000001010000, 0x0000180000000000},
+			inLieuDays: dayBits{0x0000000300000000, 0x1800000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000600000, 0x0000000000000000},
+			statutory:  dayBits{0x0000000070000001, 0x0700000000000000, 0x0000000000000000, 0x0000000000000000, 0x00000000000e0000, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 2, NewYearsDay},
+				{27, 35, SpringFestival},
+				{118, 126, LabourDay},
+				{272, 280, NationalDay},
+				{363, 364, NewYearsDay},
+			},
+		},
+		{
+			year:       2007,
+			holidays:   dayBits{0x007f000000000007, 0x7f00000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000fe0000, 0x0000180000000000},
+			workdays:   dayBits{0x0080800000000000, 0x0060000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000018000, 0x0000040000000000},
+			inLieuDays: dayBits{0x0030000000000006, 0x4800000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000300000, 0x0000100000000000},
+			statutory:  dayBits{0x0007000000000001, 0x0700000000000000, 0x0000000000000000, 0x0000000000000000, 0x00000000000e0000, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 2, NewYearsDay},
+				{47, 55, SpringFestival},
+				{117, 118, LabourDay},
+				{120, 126, LabourDay},
+				{271, 279, NationalDay},
+				{362, 364, NewYearsDay},
+			},
+		},
+		{
+			year:       2008,
+			holidays:   dayBits{0x000007f000000001, 0x0e000001c0000000, 0x00000001c0000000, 0x0000000000000000, 0x00000000007f0007, 0x0000000000000000},
+			workdays:   dayBits{0x0000000300000000, 0x1000000000000000, 0x0000000000000000, 0x0000000000000000, 0x000000000000c000, 0x0000000000000000},
+			inLieuDays: dayBits{0x0000060000000000, 0x0400000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000030000, 0x0000000000000000},
+			statutory:  dayBits{0x0000007000000001, 0x0200000040000000, 0x0000000080000000, 0x0000000000000000, 0x00000000001c0002, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 0, NewYearsDay},
+				{32, 33, SpringFestival},
+				{36, 42, SpringFestival},
+				{94, 96, TombSweepingDay},
+				{121, 124, LabourDay},
+				{158, 160, DragonBoatFestival},
+				{256, 258, MidAutumnFestival},
+				{270, 278, NationalDay},
+			},
+		},
+		{
+			year:       2009,
+			holidays:   dayBits{0x000000007f000007, 0x07000000e0000000, 0x0000000000380000, 0x0000000000000000, 0x0000000001fe0000, 0x0000000000000000},
+			workdays:   dayBits{0x0000000080800008, 0x0000000000000000, 0x0000000000400000, 0x0000000000000000, 0x0000000004002000, 0x0000000000000000},
+			inLieuDays: dayBits{0x0000000030000002, 0x0000000000000000, 0x0000000000100000, 0x0000000000000000, 0x0000000001800000, 0x0000000000000000},
+			statutory:  dayBits{0x0000000007000001, 0x0100000020000000, 0x0000000000080000, 0x0000000000000000, 0x00000000000e0000, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 3, NewYearsDay},
+				{23, 31, SpringFestival},
+				{93, 95, TombSweepingDay},
+				{120, 122, LabourDay},
+				{147, 150, DragonBoatFestival},
+				{269, 269, NationalDay},
+				{273, 274, NationalDay},
+				{275, 275, MidAutumnFestival},
+				{276, 280, NationalDay},
+				{282, 282, NationalDay},
+			},
+		},
+		{
+			year:       2010,
+			holidays:   dayBits{0x0003f80000000007, 0x0700000070000000, 0x0000007000000000, 0x0000000000000000, 0x0000000000fe0700, 0x0000000000000000},
+			workdays:   dayBits{0x000c000000000000, 0x0000000000000000, 0x0000000c00000000, 0x0000000000000000, 0x0000000002001820, 0x0000000000000000},
+			inLieuDays: dayBits{0x0003000000000000, 0x0000000000000000, 0x0000003000000000, 0x0000000000000000, 0x0000000000c00600, 0x0000000000000000},
+			statutory:  dayBits{0x0000380000000001, 0x0100000040000000, 0x0000004000000000, 0x0000000000000000, 0x00000000000e0100, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 2, NewYearsDay},
+				{43, 51, SpringFestival},
+				{92, 94, TombSweepingDay},
+				{120, 122, LabourDay},
+				{162, 166, DragonBoatFestival},
+				{261, 261, MidAutumnFestival},
+				{264, 267, MidAutumnFestival},
+				{268, 268, NationalDay},
+				{273, 279, NationalDay},
+				{281, 281, NationalDay},
+			},
+		},
+		{
+			year:       2011,
+			holidays:   dayBits{0x0000007f00000007, 0x0380000070000000, 0x0000000014000000, 0x7000000000000000, 0x0000000000fe0000, 0x0000000000000000},
+			workdays:   dayBits{0x0000040020000000, 0x0000000008000000, 0x0000000000000000, 0x0000000000000000, 0x0000000003000000, 0x0000100000000000},
+			inLieuDays: dayBits{0x0000006000000000, 0x0000000020000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000c00000, 0x0000000000000000},
+			statutory:  dayBits{0x0000000700000001, 0x0100000040000000, 0x0000000010000000, 0x4000000000000000, 0x00000000000e0000, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 2, NewYearsDay},
+				{29, 29, SpringFestival},
+				{32, 38, SpringFestival},
+				{42, 42, SpringFestival},
+				{91, 94, TombSweepingDay},
+				{119, 121, LabourDay},
+				{154, 154, DragonBoatFestival},
+				{156, 156, DragonBoatFestival},
+				{252, 254, MidAutumnFestival},
+				{273, 281, NationalDay},
+				{364, 364, NewYearsDay},
+			},
+		},
+		{
+			year:       2012,
+			holidays:   dayBits{0x000000000fe00007, 0x0380000070000000, 0x0000e00000000000, 0x0000000000000000, 0x0000000001fe0000, 0x0000000000000000},
+			workdays:   dayBits{0x0000000010100000, 0x004000000c000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000010000, 0x0000000000000000},
+			inLieuDays: dayBits{0x0000000006000004, 0x0100000030000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000400000, 0x0000000000000000},
+			statutory:  dayBits{0x0000000000e00001, 0x0200000040000000, 0x0000400000000000, 0x0000000000000000, 0x00000000001e0000, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 2, NewYearsDay},
+				{20, 28, SpringFestival},
+				{90, 94, TombSweepingDay},
+				{118, 121, LabourDay},
+				{173, 175, DragonBoatFestival},
+				{272, 272, NationalDay},
+				{273, 273, MidAutumnFestival},
+				{274, 280, NationalDay},
+			},
+		},
+		{
+			year:       2013,
+			holidays:   dayBits{0x00003f8000000007, 0x01c00000e0000000, 0x0000000700000000, 0x0000000000000000, 0x0000000000fe00e0, 0x0000000000000000},
+			workdays:   dayBits{0x0000c00000000030, 0x0030000100000000, 0x00000000c0000000, 0x0000000000000000, 0x0000000010008100, 0x0000000000000000},
+			inLieuDays: dayBits{0x0000300000000006, 0x00c0000040000000, 0x0000000300000000, 0x0000000000000000, 0x0000000000900040, 0x0000000000000000},
+			statutory:  dayBits{0x0000038000000001, 0x0100000020000000, 0x0000000400000000, 0x0000000000000000, 0x00000000000e0020, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 2, NewYearsDay},
+				{4, 5, NewYearsDay},
+				{39, 47, SpringFestival},
+				{93, 96, TombSweepingDay},
+				{116, 120, LabourDay},
+				{158, 162, DragonBoatFestival},
+				{261, 264, MidAutumnFestival},
+				{271, 271, NationalDay},
+				{273, 279, NationalDay},
+				{284, 284, NationalDay},
+			},
+		},
+		{
+			year:       2014,
+			holidays:   dayBits{0x0000001fc0000001, 0x07000001c0000000, 0x0000000001000000, 0x0400000000000000, 0x0000000000fe0000, 0x0000000000000000},
+			workdays:   dayBits{0x0000004002000000, 0x0800000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000008004000, 0x0000000000000000},
+			inLieuDays: dayBits{0x0000001800000000, 0x0200000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000c00000, 0x0000000000000000},
+			statutory:  dayBits{0x00000001c0000001, 0x0100000040000000, 0x0000000001000000, 0x0400000000000000, 0x00000000000e0000, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 0, NewYearsDay},
+				{25, 25, SpringFestival},
+				{30, 36, SpringFestival},
+				{38, 38, SpringFestival},
+				{94, 96, TombSweepingDay},
+				{120, 123, LabourDay},
+				{152, 152, DragonBoatFestival},
+				{250, 250, MidAutumnFestival},
+				{270, 270, NationalDay},
+				{273, 279, NationalDay},
+				{283, 283, NationalDay},
+			},
+		},
+		{
+			year:       2015,
+			holidays:   dayBits{0x007f000000000007, 0x01000000c0000000, 0x0000140000000000, 0x0060000000000000, 0x0000000000fe2000, 0x0000000000000000},
+			workdays:   dayBits{0x0400200000000008, 0x0000000000000000, 0x0000000000000000, 0x0100000000000000, 0x0000000004000000, 0x0000000000000000},
+			inLieuDays: dayBits{0x0060000000000002, 0x0000000000000000, 0x0000000000000000, 0x0040000000000000, 0x0000000000800000, 0x0000000000000000},
+			statutory:  dayBits{0x000e000000000001, 0x0100000040000000, 0x0000040000000000, 0x0020000000000000, 0x00000000000e2000, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 3, NewYearsDay},
+				{45, 45, SpringFestival},
+				{48, 54, SpringFestival},
+				{58, 58, SpringFestival},
+				{94, 95, TombSweepingDay},
+				{120, 120, LabourDay},
+				{170, 170, DragonBoatFestival},
+				{172, 172, DragonBoatFestival},
+				{245, 246, AntiFascist70thDay},
+				{248, 248, AntiFascist70thDay},
+				{269, 269, MidAutumnFestival},
+				{273, 279, NationalDay},
+				{282, 282, NationalDay},
+			},
+		},
+		{
+			year:       2016,
+			holidays:   dayBits{0x00000fe000000001, 0x0600000040000000, 0x0000000700000000, 0x0000000000000000, 0x0000000001fc001c, 0x0000000000000000},
+			workdays:   dayBits{0x0000101000000000, 0x0000000000000000, 0x0000000800000000, 0x0000000000000000, 0x0000000006000020, 0x0000000000000000},
+			inLieuDays: dayBits{0x0000060000000000, 0x0000000000000000, 0x0000000200000000, 0x0000000000000000, 0x0000000001800008, 0x0000000000000000},
+			statutory:  dayBits{0x000001c000000001, 0x0200000040000000, 0x0000000100000000, 0x0000000000000000, 0x00000000001c0004, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 0, NewYearsDay},
+				{36, 44, SpringFestival},
+				{94, 94, TombSweepingDay},
+				{121, 122, LabourDay},
+				{160, 163, DragonBoatFestival},
+				{258, 261, MidAutumnFestival},
+				{274, 282, NationalDay},
+			},
+		},
+		{
+			year:       2017,
+			holidays:   dayBits{0x00000001fc000003, 0x0100000038000000, 0x0000000000380000, 0x0000000000000000, 0x0000000001fe0000, 0x0000000000000000},
+			workdays:   dayBits{0x0000000400200000, 0x0000000004000000, 0x0000000000040000, 0x0000000000000000, 0x0000000000010000, 0x0000000000000000},
+			inLieuDays: dayBits{0x0000000180000000, 0x0000000010000000, 0x0000000000100000, 0x0000000000000000, 0x0000000000400000, 0x0000000000000000},
+			statutory:  dayBits{0x0000000038000001, 0x0100000020000000, 0x0000000000200000, 0x0000000000000000, 0x00000000001e0000, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 1, NewYearsDay},
+				{21, 21, SpringFestival},
+				{26, 32, SpringFestival},
+				{34, 34, SpringFestival},
+				{90, 93, TombSweepingDay},
+				{120, 120, LabourDay},
+				{146, 149, DragonBoatFestival},
+				{272, 275, NationalDay},
+				{276, 276, MidAutumnFestival},
+				{277, 280, NationalDay},
+			},
+		},
+		{
+			year:       2018,
+			holidays:   dayBits{0x000fe00000000001, 0x01c00001c0000000, 0x0000010000000000, 0x0000000000000000, 0x0000000000fe0400, 0x0000180000000000},
+			workdays:   dayBits{0x0040020000000000, 0x0020000200000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000018000, 0x0000040000000000},
+			inLieuDays: dayBits{0x000e000000000000, 0x0080000080000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000300000, 0x0000100000000000},
+			statutory:  dayBits{0x0001c00000000001, 0x0100000040000000, 0x0000010000000000, 0x0000000000000000, 0x00000000000e0400, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 0, NewYearsDay},
+				{41, 41, SpringFestival},
+				{45, 51, SpringFestival},
+				{54, 54, SpringFestival},
+				{94, 97, TombSweepingDay},
+				{117, 120, LabourDay},
+				{168, 168, DragonBoatFestival},
+				{266, 266, MidAutumnFestival},
+				{271, 279, NationalDay},
+				{362, 364, NewYearsDay},
+			},
+		},
+		{
+			year:       2019,
+			holidays:   dayBits{0x000001fc00000001, 0x0f000001c0000000, 0x00000000e0000000, 0x8000000000000000, 0x0000000000fe0003, 0x0000000000000000},
+			workdays:   dayBits{0x0000000300000000, 0x1020000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000010008000, 0x0000000000000000},
+			inLieuDays: dayBits{0x0000004400000000, 0x0600000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000900000, 0x0000000000000000},
+			statutory:  dayBits{0x0000003800000001, 0x0100000040000000, 0x0000000020000000, 0x8000000000000000, 0x00000000000e0000, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 0, NewYearsDay},
+				{32, 40, SpringFestival},
+				{94, 96, TombSweepingDay},
+				{117, 117, LabourDay},
+				{120, 124, LabourDay},
+				{157, 159, DragonBoatFestival},
+				{255, 257, MidAutumnFestival},
+				{271, 271, NationalDay},
+				{273, 279, NationalDay},
+				{284, 284, NationalDay},
+			},
+		},
+		{
+			year:       2020,
+			holidays:   dayBits{0x00000001ff800001, 0x3e000001c0000000, 0x0007000000000000, 0x0000000000000000, 0x0000000003fc0000, 0x0000000000000000},
+			workdays:   dayBits{0x0000000000040000, 0x0010000000000000, 0x0008000000000002, 0x0000000000000000, 0x0000000008004000, 0x0000000000000000},
+			inLieuDays: dayBits{0x0000000010000000, 0x3000000000000000, 0x0002000000000000, 0x0000000000000000, 0x0000000003000000, 0x0000000000000000},
+			statutory:  dayBits{0x0000000007000001, 0x0200000040000000, 0x0001000000000000, 0x0000000000000000, 0x00000000001c0000, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 0, NewYearsDay},
+				{18, 18, SpringFestival},
+				{23, 32, SpringFestival},
+				{94, 96, TombSweepingDay},
+				{116, 116, LabourDay},
+				{121, 125, LabourDay},
+				{129, 129, LabourDay},
+				{176, 179, DragonBoatFestival},
+				{270, 270, NationalDay},
+				{274, 281, NationalDay},
+				{283, 283, NationalDay},
+			},
+		},
+		{
+			year:       2021,
+			holidays:   dayBits{0x0000fe0000000007, 0x1f00000070000000, 0x0000001c00000000, 0x0000000000000000, 0x0000000000fe00e0, 0x0000000000000000},
+			workdays:   dayBits{0x0004002000000000, 0x8004000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000002001010, 0x0000000000000000},
+			inLieuDays: dayBits{0x0000c00000000000, 0x1800000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000c00040, 0x0000000000000000},
+			statutory:  dayBits{0x00001c0000000001, 0x0100000020000000, 0x0000001000000000, 0x0000000000000000, 0x00000000000e0080, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 2, NewYearsDay},
+				{37, 37, SpringFestival},
+				{41, 47, SpringFestival},
+				{50, 50, SpringFestival},
+				{92, 94, TombSweepingDay},
+				{114, 114, LabourDay},
+				{120, 124, LabourDay},
+				{127, 127, LabourDay},
+				{162, 164, DragonBoatFestival},
+				{260, 263, MidAutumnFestival},
+				{268, 268, NationalDay},
+				{273, 279, NationalDay},
+				{281, 281, NationalDay},
+			},
+		},
+		{
+			year:       2022,
+			holidays:   dayBits{0x0000001fc0000007, 0x0f80000070000000, 0x000000000e000000, 0x7000000000000000, 0x0000000000fe0000, 0x0000100000000000},
+			workdays:   dayBits{0x0000000030000000, 0x4002000008000000, 0x0000000000000000, 0x0000000000000000, 0x0000000003000000, 0x0000000000000000},
+			inLieuDays: dayBits{0x0000000600000000, 0x0c00000020000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000c00000, 0x0000000000000000},
+			statutory:  dayBits{0x0000000380000001, 0x0100000040000000, 0x0000000002000000, 0x1000000000000000, 0x00000000000e0000, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 2, NewYearsDay},
+				{28, 36, SpringFestival},
+				{91, 94, TombSweepingDay},
+				{113, 113, LabourDay},
+				{119, 123, LabourDay},
+				{126, 126, LabourDay},
+				{153, 155, DragonBoatFestival},
+				{252, 254, MidAutumnFestival},
+				{273, 281, NationalDay},
+				{364, 364, NewYearsDay},
+			},
+		},
+		{
+			year:       2023,
+			holidays:   dayBits{0x0000000007f00003, 0x07c0000040000000, 0x0000700000000000, 0x0000000000000000, 0x00000000007f8000, 0x0000000000000000},
+			workdays:   dayBits{0x0000000018000000, 0x2001000000000000, 0x0000800000000000, 0x0000000000000000, 0x0000000001800000, 0x0000000000000000},
+			inLieuDays: dayBits{0x0000000006000000, 0x0600000000000000, 0x0000200000000000, 0x0000000000000000, 0x0000000000600000, 0x0000000000000000},
+			statutory:  dayBits{0x0000000000e00001, 0x0100000040000000, 0x0000100000000000, 0x0000000000000000, 0x00000000000e8000, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 1, NewYearsDay},
+				{20, 28, SpringFestival},
+				{94, 94, TombSweepingDay},
+				{112, 112, LabourDay},
+				{118, 122, LabourDay},
+				{125, 125, LabourDay},
+				{172, 175, DragonBoatFestival},
+				{271, 271, MidAutumnFestival},
+				{272, 280, NationalDay},
+			},
+		},
+		{
+			year:       2024,
+			holidays:   dayBits{0x0000ff0000000001, 0x3e000001c0000000, 0x0000000200000000, 0x0000000000000000, 0x0000000001fc001c, 0x0000000000000000},
+			workdays:   dayBits{0x0001000400000000, 0x0040000200000000, 0x0000000000000008, 0x0000000000000000, 0x0000000020010002, 0x0000000000000000},
+			inLieuDays: dayBits{0x0000600000000000, 0x0c00000080000000, 0x0000000000000000, 0x0000000000000000, 0x0000000001200010, 0x0000000000000000},
+			statutory:  dayBits{0x0000070000000001, 0x0200000040000000, 0x0000000200000000, 0x0000000000000000, 0x00000000001c0010, 0x0000000000000000},
+			tags: []dayTag{
+				{0, 0, NewYearsDay},
+				{34, 34, SpringFestival},
+				{40, 48, SpringFestival},
+				{94, 97, TombSweepingDay},
+				{118, 118, LabourDay},
+				{121, 125, LabourDay},
+				{131, 131, LabourDay},
+				{161, 161, DragonBoatFestival},
+				{257, 260, MidAutumnFestival},
+				{272, 272, NationalDay},
+				{274, 280, NationalDay},
+				{285, 285, NationalDay},
+			},
+		},
 	}
 )
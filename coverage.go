@@ -0,0 +1,47 @@
+package chinesecalendar
+
+import "time"
+
+// Coverage 数据对某一年的覆盖程度
+type Coverage int
+
+const (
+	// NotCovered 该年不在数据范围内
+	NotCovered Coverage = iota
+	// PartiallyCovered 只有该年的部分日期在数据范围内，例如数据截至 10 月 12 日
+	PartiallyCovered
+	// FullyCovered 该年全年都在数据范围内
+	FullyCovered
+)
+
+var coverageNames = map[Coverage]string{
+	NotCovered:       "not covered",
+	PartiallyCovered: "partial",
+	FullyCovered:     "complete",
+}
+
+func (cv Coverage) String() string {
+	if name, ok := coverageNames[cv]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// SupportedRange 返回数据覆盖的第一天与最后一天（包括起止日期），为 ChinaStandardTime 时区的零点。
+// 范围之外的日期，IsWorkday 等函数返回 false，返回 error 的函数返回 *DateRangeError
+func (c *Calendar) SupportedRange() (start, end time.Time) {
+	return c.minDay.time(ChinaStandardTime), c.maxDay.time(ChinaStandardTime)
+}
+
+// YearCoverage 返回数据对 year 年的覆盖程度
+func (c *Calendar) YearCoverage(year int) Coverage {
+	first, last := civilDate{year, time.January, 1}, civilDate{year, time.December, 31}
+	switch {
+	case year < c.minDay.year || year > c.maxDay.year:
+		return NotCovered
+	case c.isSupported(first) && c.isSupported(last):
+		return FullyCovered
+	default:
+		return PartiallyCovered
+	}
+}
@@ -0,0 +1,39 @@
+package chinesecalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSupportedRange(t *testing.T) {
+	start, end := SupportedRange()
+	assert.Equal(t, time.Date(2004, 1, 1, 0, 0, 0, 0, ChinaStandardTime), start)
+	assert.Equal(t, time.Date(2024, 10, 12, 0, 0, 0, 0, ChinaStandardTime), end)
+
+	// 数据截至 2024-10-12，之后的日期不再按普通工作日处理
+	assert.Equal(t, false, IsWorkday(time.Date(2024, 12, 25, 0, 0, 0, 0, ChinaStandardTime)))
+	_, err := CheckWorkday(time.Date(2024, 10, 13, 0, 0, 0, 0, ChinaStandardTime))
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+	_, err = GetDayType(time.Date(2024, 12, 25, 0, 0, 0, 0, ChinaStandardTime))
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+	_, err = NextWorkday(time.Date(2024, 10, 12, 0, 0, 0, 0, ChinaStandardTime))
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+}
+
+func TestYearCoverage(t *testing.T) {
+	assert.Equal(t, NotCovered, YearCoverage(2003))
+	assert.Equal(t, FullyCovered, YearCoverage(2004))
+	assert.Equal(t, FullyCovered, YearCoverage(2023))
+	assert.Equal(t, PartiallyCovered, YearCoverage(2024))
+	assert.Equal(t, NotCovered, YearCoverage(2025))
+	assert.Equal(t, "partial", PartiallyCovered.String())
+
+	c, err := NewCalendar(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, PartiallyCovered, c.YearCoverage(2024))
+	assert.Equal(t, FullyCovered, c.YearCoverage(2025))
+	assert.Equal(t, false, c.IsWorkday(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, true, c.IsWorkday(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,43 @@
+package chinesecalendar
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// data 目录下的数据由 scripts/generator.go 与 constants.go 一同生成，两者必须一致
+
+func TestJSONData(t *testing.T) {
+	c, err := LoadJSONFile("data/chinesecalendar.json")
+	require.NoError(t, err)
+	assert.Equal(t, defaultCalendar.minDay, c.minDay)
+	assert.Equal(t, defaultCalendar.maxDay, c.maxDay)
+	assert.Equal(t, defaultCalendar.tables, c.tables)
+
+	buffer := &bytes.Buffer{}
+	require.NoError(t, defaultCalendar.WriteJSON(buffer))
+	content, err := os.ReadFile("data/chinesecalendar.json")
+	require.NoError(t, err)
+	assert.Equal(t, buffer.String(), string(content))
+}
+
+func TestYAMLData(t *testing.T) {
+	content, err := os.ReadFile("data/chinesecalendar.yaml")
+	require.NoError(t, err)
+	var data map[string]interface{}
+	require.NoError(t, yaml.Unmarshal(content, &data))
+	b, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	c, err := LoadJSON(bytes.NewReader(b))
+	require.NoError(t, err)
+	assert.Equal(t, defaultCalendar.minDay, c.minDay)
+	assert.Equal(t, defaultCalendar.maxDay, c.maxDay)
+	assert.Equal(t, defaultCalendar.tables, c.tables)
+}
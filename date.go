@@ -0,0 +1,68 @@
+package chinesecalendar
+
+import (
+	"fmt"
+	"time"
+)
+
+// ChinaStandardTime 中国标准时间（UTC+8），中国自 1991 年起不再实行夏令时
+var ChinaStandardTime = time.FixedZone("CST", 8*60*60)
+
+// civilDate 不带时区的公历日期，作为节假日数据的索引
+type civilDate struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+// civilDateOf 取 t 在中国的日期，即 t.In(ChinaStandardTime) 的年月日，与 t 的时区及运行环境的 TZ 无关。
+// 传入 UTC 的 2022-09-30T20:00:00Z 与 Asia/Shanghai 的 2022-10-01 04:00:00 都会得到 2022-10-01
+func civilDateOf(t time.Time) civilDate {
+	return dateOf(t.In(ChinaStandardTime))
+}
+
+// dateOf 取 t 在其自身时区下的年月日，用于按其他时区计算的场合（见 WorkingHours）
+func dateOf(t time.Time) civilDate {
+	year, month, day := t.Date()
+	return civilDate{year, month, day}
+}
+
+// time 返回该日期在 loc 时区的零点
+func (d civilDate) time(loc *time.Location) time.Time {
+	return time.Date(d.year, d.month, d.day, 0, 0, 0, 0, loc)
+}
+
+func (d civilDate) addDays(n int) civilDate {
+	return dateOf(d.time(time.UTC).AddDate(0, 0, n))
+}
+
+func (d civilDate) weekday() time.Weekday {
+	return weekdayOf(d.year, d.month, d.day)
+}
+
+func (d civilDate) isWeekend() bool {
+	return d.weekday() == time.Saturday || d.weekday() == time.Sunday
+}
+
+func (d civilDate) before(other civilDate) bool {
+	if d.year != other.year {
+		return d.year < other.year
+	}
+	if d.month != other.month {
+		return d.month < other.month
+	}
+	return d.day < other.day
+}
+
+func (d civilDate) after(other civilDate) bool {
+	return other.before(d)
+}
+
+// daysUntil 返回从 d 到 other 相差的天数
+func (d civilDate) daysUntil(other civilDate) int {
+	return int(other.time(time.UTC).Sub(d.time(time.UTC)) / oneDay)
+}
+
+func (d civilDate) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.year, d.month, d.day)
+}
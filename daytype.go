@@ -0,0 +1,52 @@
+package chinesecalendar
+
+import "time"
+
+// DayType 日期类型
+type DayType int
+
+const (
+	// Workday 正常工作日
+	Workday DayType = iota
+	// Weekend 周末，包括放假安排中落在周末、又不是法定假日的日期
+	Weekend
+	// StatutoryHoliday 法定假日，加班支付 300% 工资
+	StatutoryHoliday
+	// AdjustedRestDay 调休放假，因放假安排休息的周一至周五
+	AdjustedRestDay
+	// AdjustedWorkday 调休上班，因放假安排上班的周末
+	AdjustedWorkday
+)
+
+var dayTypeNames = [...]string{"工作日", "周末", "法定假日", "调休放假", "调休上班"}
+
+func (dt DayType) String() string {
+	if dt < 0 || int(dt) >= len(dayTypeNames) {
+		return "未知"
+	}
+	return dayTypeNames[dt]
+}
+
+// GetDayType 获取日期类型，如果日期不符合，返回 ErrUnSupportDate
+func (c *Calendar) GetDayType(t time.Time) (DayType, error) {
+	d, isValidate := c.validateDate(t)
+	if !isValidate {
+		return Workday, c.rangeError(civilDateOf(t), ChinaStandardTime)
+	}
+	return c.getDayType(d), nil
+}
+
+func (c *Calendar) getDayType(d civilDate) DayType {
+	tb, i := c.tableOf(d)
+	switch {
+	case tb.workdays.has(i):
+		return AdjustedWorkday
+	case tb.statutory.has(i):
+		return StatutoryHoliday
+	case tb.weekends().has(i):
+		return Weekend
+	case tb.holidays.has(i):
+		return AdjustedRestDay
+	}
+	return Workday
+}
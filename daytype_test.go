@@ -0,0 +1,82 @@
+package chinesecalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDayType(t *testing.T) {
+	args := []struct {
+		date   time.Time
+		expect DayType
+	}{
+		{time.Date(2024, 9, 27, 0, 0, 0, 0, ChinaStandardTime), Workday},
+		{time.Date(2024, 9, 28, 0, 0, 0, 0, ChinaStandardTime), Weekend},
+		{time.Date(2024, 9, 29, 0, 0, 0, 0, ChinaStandardTime), AdjustedWorkday},
+		{time.Date(2024, 10, 1, 0, 0, 0, 0, ChinaStandardTime), StatutoryHoliday},
+		{time.Date(2024, 10, 3, 0, 0, 0, 0, ChinaStandardTime), StatutoryHoliday},
+		{time.Date(2024, 10, 4, 0, 0, 0, 0, ChinaStandardTime), AdjustedRestDay},
+		{time.Date(2024, 10, 5, 0, 0, 0, 0, ChinaStandardTime), Weekend},
+		{time.Date(2024, 10, 7, 0, 0, 0, 0, ChinaStandardTime), AdjustedRestDay},
+		// 2022 年元旦是周六
+		{time.Date(2022, 1, 1, 0, 0, 0, 0, ChinaStandardTime), StatutoryHoliday},
+		{time.Date(2022, 1, 3, 0, 0, 0, 0, ChinaStandardTime), AdjustedRestDay},
+		// 2008 年至 2013 年春节法定假日从除夕开始
+		{time.Date(2013, 2, 9, 0, 0, 0, 0, ChinaStandardTime), StatutoryHoliday},
+		{time.Date(2013, 2, 12, 0, 0, 0, 0, ChinaStandardTime), AdjustedRestDay},
+		{time.Date(2007, 5, 3, 0, 0, 0, 0, ChinaStandardTime), StatutoryHoliday},
+		{time.Date(2015, 9, 3, 0, 0, 0, 0, ChinaStandardTime), StatutoryHoliday},
+	}
+	for _, arg := range args {
+		dayType, err := GetDayType(arg.date)
+		assert.NoError(t, err)
+		assert.Equal(t, arg.expect, dayType, arg.date.Format("2006-01-02"))
+	}
+
+	_, err := GetDayType(time.Date(2001, 1, 1, 0, 0, 0, 0, ChinaStandardTime))
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+	assert.Equal(t, "法定假日", StatutoryHoliday.String())
+}
+
+// TestStatutoryDaysOfPeriods 放假区间内的法定假日天数与各节日的规定天数一致，
+// 中秋节与国庆节重合的年份除外
+// TestDragonBoatFestival2012 2012 年端午节（6 月 23 日，周六）曾缺少放假安排，被当作普通周末
+func TestDragonBoatFestival2012(t *testing.T) {
+	festival := time.Date(2012, 6, 23, 0, 0, 0, 0, ChinaStandardTime)
+	dayType, err := GetDayType(festival)
+	assert.NoError(t, err)
+	assert.Equal(t, StatutoryHoliday, dayType)
+	holiday, isHoliday := GetHolidayDetail(festival)
+	assert.Equal(t, true, isHoliday)
+	assert.Equal(t, DragonBoatFestival, holiday)
+
+	period, ok := GetHolidayPeriod(festival)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, time.Date(2012, 6, 22, 0, 0, 0, 0, ChinaStandardTime), period.Start)
+	assert.Equal(t, time.Date(2012, 6, 24, 0, 0, 0, 0, ChinaStandardTime), period.End)
+	assert.Equal(t, []time.Time{festival}, period.StatutoryDays)
+}
+
+func TestStatutoryDaysOfPeriods(t *testing.T) {
+	overlapped := map[int]bool{2009: true, 2020: true}
+	for year := minDay.year; year <= maxDay.year; year++ {
+		periods, err := GetHolidayPeriods(year)
+		assert.NoError(t, err)
+		for _, period := range periods {
+			n := 0
+			for _, holiday := range period.Holidays {
+				n += holiday.StatutoryDays(year)
+			}
+			if overlapped[year] && period.Start.Month() == time.October {
+				n = 3
+			}
+			assert.Equal(t, n, len(period.StatutoryDays), period.Start.Format("2006-01-02"))
+			for _, d := range period.StatutoryDays {
+				dayType, _ := GetDayType(d)
+				assert.Equal(t, StatutoryHoliday, dayType)
+			}
+		}
+	}
+}
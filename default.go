@@ -0,0 +1,163 @@
+package chinesecalendar
+
+import (
+	"io"
+	"time"
+)
+
+// defaultCalendar 内置的国务院放假安排，由 scripts/generator 生成
+var defaultCalendar = &Calendar{minDay: minDay, maxDay: maxDay, tables: yearTables, groups: groupHolidays, feed: regionFeeds[Mainland]}
+
+// Default 返回内置数据的日历，包级函数都使用该日历
+func Default() *Calendar {
+	return defaultCalendar
+}
+
+// SupportedRange 返回内置数据覆盖的第一天与最后一天，见 Calendar.SupportedRange
+func SupportedRange() (start, end time.Time) {
+	return defaultCalendar.SupportedRange()
+}
+
+// YearCoverage 返回内置数据对 year 年的覆盖程度
+func YearCoverage(year int) Coverage {
+	return defaultCalendar.YearCoverage(year)
+}
+
+// IsWorkday 检查是否是工作日
+// return false if the t is not in the range of SupportedRange
+func IsWorkday(t time.Time) bool {
+	return defaultCalendar.IsWorkday(t)
+}
+
+// IsHoliday 检查是否节假日
+// return false if the t is not in the range of SupportedRange
+func IsHoliday(t time.Time) bool {
+	return defaultCalendar.IsHoliday(t)
+}
+
+// IsInLieu 检查是否调休日
+// return false if the t is not in the range of SupportedRange
+func IsInLieu(t time.Time) bool {
+	return defaultCalendar.IsInLieu(t)
+}
+
+// CheckWorkday 检查是否是工作日，t 超出支持范围时返回 *DateRangeError
+func CheckWorkday(t time.Time) (bool, error) {
+	return defaultCalendar.CheckWorkday(t)
+}
+
+// CheckHoliday 检查是否节假日，t 超出支持范围时返回 *DateRangeError
+func CheckHoliday(t time.Time) (bool, error) {
+	return defaultCalendar.CheckHoliday(t)
+}
+
+// CheckInLieu 检查是否调休日，t 超出支持范围时返回 *DateRangeError
+func CheckInLieu(t time.Time) (bool, error) {
+	return defaultCalendar.CheckInLieu(t)
+}
+
+// AttendanceFor 返回 group 在 t 当天需要上班的部分，见 Calendar.AttendanceFor
+func AttendanceFor(t time.Time, group Group) (Attendance, error) {
+	return defaultCalendar.AttendanceFor(t, group)
+}
+
+// GetHolidayDetailFor 获取 group 在 t 当天的节假日详细信息，见 Calendar.GetHolidayDetailFor
+func GetHolidayDetailFor(t time.Time, group Group) (Holiday, bool) {
+	return defaultCalendar.GetHolidayDetailFor(t, group)
+}
+
+// IsWorkdayFor 检查 t 当天 group 是否需要上班，只放假半天时也需要上班
+// return false if the t is not in the range of SupportedRange
+func IsWorkdayFor(t time.Time, group Group) bool {
+	return defaultCalendar.IsWorkdayFor(t, group)
+}
+
+// GetHolidayDetail 获取节假日详细信息
+func GetHolidayDetail(t time.Time) (Holiday, bool) {
+	return defaultCalendar.GetHolidayDetail(t)
+}
+
+// GetHolidays 获取时间区间内的节假日（包括起止时间），如果日期不符合，返回空切片
+// 返回的日期为 ChinaStandardTime 的零点
+func GetHolidays(start, end time.Time, includeWeekends bool) ([]time.Time, error) {
+	return defaultCalendar.GetHolidays(start, end, includeWeekends)
+}
+
+// GetWorkdays 获取时间区间内的（包括起止时间），如果日期不符合，返回空切片
+// 返回的日期为 ChinaStandardTime 的零点
+func GetWorkdays(start, end time.Time) ([]time.Time, error) {
+	return defaultCalendar.GetWorkdays(start, end)
+}
+
+// CountWorkdays 统计时间区间内的工作日天数，默认包括起止时间，传入 HalfOpen 时不包括结束日期，
+// end 早于 start 等区间为空的情况返回 0（见 Interval）
+func CountWorkdays(start, end time.Time, interval ...Interval) (int, error) {
+	return defaultCalendar.CountWorkdays(start, end, interval...)
+}
+
+// CountWorkdaysFor 统计时间区间内 group 的出勤天数，放假半天的日期计为 0.5 天，
+// 默认包括起止时间，传入 HalfOpen 时不包括结束日期
+func CountWorkdaysFor(start, end time.Time, group Group, interval ...Interval) (float64, error) {
+	return defaultCalendar.CountWorkdaysFor(start, end, group, interval...)
+}
+
+// CountHolidays 统计时间区间内的节假日天数，默认包括起止时间，传入 HalfOpen 时不包括结束日期，
+// end 早于 start 等区间为空的情况返回 0（见 Interval）
+// includeWeekends 的含义与 GetHolidays 相同
+func CountHolidays(start, end time.Time, includeWeekends bool, interval ...Interval) (int, error) {
+	return defaultCalendar.CountHolidays(start, end, includeWeekends, interval...)
+}
+
+// AddWorkdays 返回 t 之后第 n 个工作日（n 为负数时为之前第 -n 个），n 为 0 时返回 t 当天。
+// 调休上班日计为工作日。返回的日期为 ChinaStandardTime 的零点，
+// 如果 t 或计算过程中经过的日期超出支持范围，返回 ErrUnSupportDate
+func AddWorkdays(t time.Time, n int) (time.Time, error) {
+	return defaultCalendar.AddWorkdays(t, n)
+}
+
+// SubWorkdays 返回 t 之前第 n 个工作日，等同于 AddWorkdays(t, -n)
+func SubWorkdays(t time.Time, n int) (time.Time, error) {
+	return defaultCalendar.SubWorkdays(t, n)
+}
+
+// NextWorkday 返回 t 之后的第一个工作日，日期为 ChinaStandardTime 的零点
+func NextWorkday(t time.Time) (time.Time, error) {
+	return defaultCalendar.NextWorkday(t)
+}
+
+// PrevWorkday 返回 t 之前的最后一个工作日，日期为 ChinaStandardTime 的零点
+func PrevWorkday(t time.Time) (time.Time, error) {
+	return defaultCalendar.PrevWorkday(t)
+}
+
+// NextHoliday 返回 t 之后的第一个节假日（包括周末），日期为 ChinaStandardTime 的零点
+func NextHoliday(t time.Time) (time.Time, error) {
+	return defaultCalendar.NextHoliday(t)
+}
+
+// PrevHoliday 返回 t 之前的最后一个节假日（包括周末），日期为 ChinaStandardTime 的零点
+func PrevHoliday(t time.Time) (time.Time, error) {
+	return defaultCalendar.PrevHoliday(t)
+}
+
+// GetHolidayPeriod 获取 t 所在的放假区间，t 不在任何放假区间内时返回 false。
+// 返回的日期为 ChinaStandardTime 的零点
+func GetHolidayPeriod(t time.Time) (HolidayPeriod, bool) {
+	return defaultCalendar.GetHolidayPeriod(t)
+}
+
+// GetHolidayPeriods 获取某一年的全部放假区间，按结束日期所在年份归属。
+// 返回的日期为 ChinaStandardTime 时区的零点
+func GetHolidayPeriods(year int) ([]HolidayPeriod, error) {
+	return defaultCalendar.GetHolidayPeriods(year)
+}
+
+// GetDayType 获取日期类型，如果日期不符合，返回 ErrUnSupportDate
+func GetDayType(t time.Time) (DayType, error) {
+	return defaultCalendar.GetDayType(t)
+}
+
+// WriteICS 将内置数据 [start, end] 内的放假安排写为 iCalendar 数据，见 Calendar.WriteICS
+func WriteICS(w io.Writer, start, end time.Time) error {
+	return defaultCalendar.WriteICS(w, start, end)
+}
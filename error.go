@@ -1,5 +1,68 @@
 package chinesecalendar
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
-var ErrUnSupportDate = fmt.Errorf("unsupported date, supported date range is %s - %s", minDay.Format(dateFormatYYYYMMDD), maxDay.Format(dateFormatYYYYMMDD))
+// ErrUnSupportDate 日期超出支持范围。Calendar 的方法返回带有日期与支持范围的 *DateRangeError，
+// 请用 errors.Is(err, ErrUnSupportDate) 判断
+var ErrUnSupportDate = fmt.Errorf("unsupported date, supported date range is %s - %s", minDay, maxDay)
+
+// ErrInvalidArrangement 放假安排数据不合法
+var ErrInvalidArrangement = errors.New("invalid arrangement")
+
+// ErrUnsupportedVersion 数据格式的版本不受支持
+var ErrUnsupportedVersion = errors.New("unsupported data version")
+
+// ErrUnSupportLunarDate 农历日期超出支持范围
+var ErrUnSupportLunarDate = fmt.Errorf("unsupported lunar date, supported lunar years are %d - %d", lunarEpoch.year, lunarEpoch.year+len(lunarYears)-1)
+
+// ErrInvalidLunarDate 农历日期不存在，例如该年没有这个闰月
+var ErrInvalidLunarDate = errors.New("invalid lunar date")
+
+// ErrUnSupportSolarTermDate 节气数据的年份超出支持范围
+var ErrUnSupportSolarTermDate = fmt.Errorf("unsupported date for solar terms, supported years are %d - %d", solarTermMinYear, solarTermMinYear+len(solarTermMinutes)-1)
+
+// ErrUnSupportProjectedYear 推算法定假日的年份超出支持范围
+var ErrUnSupportProjectedYear = fmt.Errorf("unsupported year for projected holidays, supported years are %d - %d", solarTermMinYear, solarTermMinYear+len(solarTermMinutes)-1)
+
+// DateRangeError 日期超出日历的支持范围，errors.Is(err, ErrUnSupportDate) 为 true
+type DateRangeError struct {
+	// Date 超出范围的日期
+	Date time.Time
+	// Min 支持范围的第一天
+	Min time.Time
+	// Max 支持范围的最后一天
+	Max time.Time
+}
+
+func (e *DateRangeError) Error() string {
+	return fmt.Sprintf("unsupported date %s, supported date range is %s - %s",
+		e.Date.Format("2006-01-02"), e.Min.Format("2006-01-02"), e.Max.Format("2006-01-02"))
+}
+
+// Is 使 errors.Is(err, ErrUnSupportDate) 成立
+func (e *DateRangeError) Is(target error) bool {
+	return target == ErrUnSupportDate
+}
+
+// Before 日期是否早于支持范围的第一天
+func (e *DateRangeError) Before() bool {
+	return civilDateOf(e.Date).before(civilDateOf(e.Min))
+}
+
+// After 日期是否晚于支持范围的最后一天
+func (e *DateRangeError) After() bool {
+	return civilDateOf(e.Date).after(civilDateOf(e.Max))
+}
+
+// ErrInvalidWorkingHours 工作时间的配置不合法
+var ErrInvalidWorkingHours = errors.New("invalid working hours")
+
+// ErrUnknownRegion 地区不存在
+var ErrUnknownRegion = errors.New("unknown region")
+
+// ErrUnknownProvince 省、自治区不存在或没有地方节日
+var ErrUnknownProvince = errors.New("unknown province")
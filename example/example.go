@@ -9,14 +9,14 @@ import (
 
 func main() {
 	// 判断是否节假日
-	t := time.Date(2022, 2, 25, 0, 0, 0, 0, time.Local)
+	t := time.Date(2022, 2, 25, 0, 0, 0, 0, chinesecalendar.ChinaStandardTime)
 	fmt.Printf("IsHoliday: %v\n", chinesecalendar.IsHoliday(t))
 	fmt.Printf("IsWorkday: %v\n", chinesecalendar.IsWorkday(t))
 	// output:
 	// IsHoliday: false
 	// IsWorkday: true
 
-	t1 := time.Date(2022, 2, 26, 0, 0, 0, 0, time.Local)
+	t1 := time.Date(2022, 2, 26, 0, 0, 0, 0, chinesecalendar.ChinaStandardTime)
 	fmt.Printf("IsHoliday: %v\n", chinesecalendar.IsHoliday(t1))
 	fmt.Printf("IsWorkday: %v\n", chinesecalendar.IsWorkday(t1))
 	// output:
@@ -24,7 +24,7 @@ func main() {
 	// IsWorkday: false
 
 	// 获取节日名
-	t2 := time.Date(2022, 1, 1, 0, 0, 0, 0, time.Local)
+	t2 := time.Date(2022, 1, 1, 0, 0, 0, 0, chinesecalendar.ChinaStandardTime)
 	holiday, isHoliday := chinesecalendar.GetHolidayDetail(t2)
 	if isHoliday {
 		fmt.Printf("节日: %v\n", holiday.Name())
@@ -33,7 +33,7 @@ func main() {
 	// 节日: 元旦
 
 	// 判断节日是否调休
-	t3 := time.Date(2022, 2, 3, 0, 0, 0, 0, time.Local)
+	t3 := time.Date(2022, 2, 3, 0, 0, 0, 0, chinesecalendar.ChinaStandardTime)
 	fmt.Printf("IsInLieu: %v\n", chinesecalendar.IsInLieu(t3))
 	// output:
 	// IsInLieu: true
@@ -0,0 +1,161 @@
+package chinesecalendar
+
+import (
+	"strings"
+	"time"
+)
+
+// Group 部分公民放假的节日所针对的人群，可以用 | 组合，例如 Women | Military
+type Group uint
+
+const (
+	// Women 妇女，妇女节（3 月 8 日）放假半天
+	Women Group = 1 << iota
+	// Youth 14 周岁以上的青年，青年节（5 月 4 日）放假半天
+	Youth
+	// Children 不满 14 周岁的少年儿童，儿童节（6 月 1 日）放假 1 天
+	Children
+	// Military 现役军人，建军节（8 月 1 日）放假半天
+	Military
+)
+
+var groupNames = [...]string{"妇女", "青年", "少年儿童", "现役军人"}
+
+func (g Group) String() string {
+	names := make([]string, 0, len(groupNames))
+	for i, name := range groupNames {
+		if g&(1<<uint(i)) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// 部分公民放假的节日定义。儿童节为六一国际儿童节，与香港、台湾的儿童节（ChildrensDay）不同
+var (
+	WomensDay                 = Holiday{"International Women's Day", "妇女节", 0, NationalScope}
+	YouthDay                  = Holiday{"Youth Day", "青年节", 0, NationalScope}
+	InternationalChildrensDay = Holiday{"International Children's Day", "儿童节", 0, NationalScope}
+	ArmyDay                   = Holiday{"Army Day", "建军节", 0, NationalScope}
+)
+
+// Attendance 一天中需要上班的部分
+type Attendance int
+
+const (
+	// RestAllDay 全天休息
+	RestAllDay Attendance = iota
+	// WorkHalfDay 上半天班，另外半天放假，放假的是上午还是下午由单位安排
+	WorkHalfDay
+	// WorkFullDay 全天上班
+	WorkFullDay
+)
+
+var attendanceNames = [...]string{"休息", "半天", "全天"}
+
+func (a Attendance) String() string {
+	if a < 0 || int(a) >= len(attendanceNames) {
+		return "未知"
+	}
+	return attendanceNames[a]
+}
+
+// Days 上班的天数，半天为 0.5
+func (a Attendance) Days() float64 {
+	return float64(a) / 2
+}
+
+// groupHoliday 部分公民放假的节日，attendance 为当天需要上班的部分
+type groupHoliday struct {
+	group      Group
+	month      time.Month
+	day        int
+	holiday    Holiday
+	attendance Attendance
+}
+
+// groupHolidays 《全国年节及纪念日放假办法》规定的部分公民放假的节日，适逢周六、周日时不补假
+var groupHolidays = []groupHoliday{
+	{Women, time.March, 8, WomensDay, WorkHalfDay},
+	{Youth, time.May, 4, YouthDay, WorkHalfDay},
+	{Children, time.June, 1, InternationalChildrensDay, RestAllDay},
+	{Military, time.August, 1, ArmyDay, WorkHalfDay},
+}
+
+// groupHolidayOf 返回 group 在工作日 d 当天放假的节日，没有时返回 false
+func (c *Calendar) groupHolidayOf(d civilDate, group Group) (groupHoliday, bool) {
+	for _, h := range c.groups {
+		if group&h.group != 0 && d.month == h.month && d.day == h.day {
+			return h, true
+		}
+	}
+	return groupHoliday{}, false
+}
+
+// attendanceFor 返回 group 在 d 当天需要上班的部分，d 须在支持范围内
+func (c *Calendar) attendanceFor(d civilDate, group Group) Attendance {
+	if !c.isWorkday(d) {
+		return RestAllDay
+	}
+	attendance := WorkFullDay
+	if tb, i := c.tableOf(d); tb.halfDays.has(i) {
+		attendance = WorkHalfDay
+	}
+	if h, ok := c.groupHolidayOf(d, group); ok && h.attendance < attendance {
+		attendance = h.attendance
+	}
+	return attendance
+}
+
+// AttendanceFor 返回 group 在 t 当天需要上班的部分：
+// 部分公民放假的节日为工作日（包括调休上班日）时，group 中的人群按规定放假半天或 1 天，为休息日时不补假。
+// 只放假半天的工作日（见 Arrangement.HalfDay、Overlay.AddHalfRestDay）对所有人都返回 WorkHalfDay，group 为 0 时只考虑这些日期。
+// 部分公民放假的节日是大陆的规定，只适用于大陆的日历（包括 NewCalendar、LoadJSON 等构建的日历及其叠加地方节日、调整后的日历），
+// 香港、澳门、台湾的日历（见 RegionCalendar）没有部分公民放假的节日，结果与 group 无关。
+// t 超出支持范围时返回 *DateRangeError
+func (c *Calendar) AttendanceFor(t time.Time, group Group) (Attendance, error) {
+	d, isValidate := c.validateDate(t)
+	if !isValidate {
+		return RestAllDay, c.rangeError(civilDateOf(t), ChinaStandardTime)
+	}
+	return c.attendanceFor(d, group), nil
+}
+
+// GetHolidayDetailFor 获取 group 在 t 当天的节假日详细信息：
+// 部分公民放假的节日为工作日时返回该节日（例如 WomensDay），只放假半天时也返回 true，
+// 只放假半天的工作日返回放假的原因，其他日期与 GetHolidayDetail 相同
+func (c *Calendar) GetHolidayDetailFor(t time.Time, group Group) (Holiday, bool) {
+	d, isValidate := c.validateDate(t)
+	if !isValidate {
+		return Holiday{}, false
+	}
+	if h, ok := c.groupHolidayOf(d, group); ok && c.isWorkday(d) {
+		return h.holiday, true
+	}
+	if tb, i := c.tableOf(d); tb.halfDays.has(i) {
+		return tb.holidayAt(i)
+	}
+	return c.GetHolidayDetail(t)
+}
+
+// IsWorkdayFor 检查 t 当天 group 是否需要上班，只放假半天时也需要上班，详见 AttendanceFor
+// return false if the t is not in the range of SupportedRange
+func (c *Calendar) IsWorkdayFor(t time.Time, group Group) bool {
+	attendance, err := c.AttendanceFor(t, group)
+	return err == nil && attendance != RestAllDay
+}
+
+// CountWorkdaysFor 统计时间区间内 group 的出勤天数，放假半天的日期计为 0.5 天，
+// 默认包括起止时间，传入 HalfOpen 时不包括结束日期
+func (c *Calendar) CountWorkdaysFor(start, end time.Time, group Group, interval ...Interval) (float64, error) {
+	startDay, endDay, err := c.validateRange(start, end, interval...)
+	if err != nil {
+		return 0, err
+	}
+
+	var days float64
+	for d := startDay; !d.after(endDay); d = d.addDays(1) {
+		days += c.attendanceFor(d, group).Days()
+	}
+	return days, nil
+}
@@ -0,0 +1,137 @@
+package chinesecalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttendanceFor(t *testing.T) {
+	womensDay := time.Date(2024, 3, 8, 0, 0, 0, 0, ChinaStandardTime)
+	attendance, err := AttendanceFor(womensDay, Women)
+	assert.NoError(t, err)
+	assert.Equal(t, WorkHalfDay, attendance)
+	attendance, _ = AttendanceFor(womensDay, Women|Military)
+	assert.Equal(t, WorkHalfDay, attendance)
+	attendance, _ = AttendanceFor(womensDay, Youth)
+	assert.Equal(t, WorkFullDay, attendance)
+	attendance, _ = AttendanceFor(womensDay, 0)
+	assert.Equal(t, WorkFullDay, attendance)
+
+	// 青年节适逢周六不补假；2008 年 5 月 4 日为调休上班日，青年放假半天
+	attendance, _ = AttendanceFor(time.Date(2024, 5, 4, 0, 0, 0, 0, ChinaStandardTime), Youth)
+	assert.Equal(t, RestAllDay, attendance)
+	attendance, _ = AttendanceFor(time.Date(2008, 5, 4, 0, 0, 0, 0, ChinaStandardTime), Youth)
+	assert.Equal(t, WorkHalfDay, attendance)
+
+	attendance, _ = AttendanceFor(time.Date(2023, 6, 1, 0, 0, 0, 0, ChinaStandardTime), Children)
+	assert.Equal(t, RestAllDay, attendance)
+	attendance, _ = AttendanceFor(time.Date(2024, 8, 1, 0, 0, 0, 0, ChinaStandardTime), Military)
+	assert.Equal(t, WorkHalfDay, attendance)
+
+	_, err = AttendanceFor(time.Date(2030, 3, 8, 0, 0, 0, 0, ChinaStandardTime), Women)
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+}
+
+func TestIsWorkdayFor(t *testing.T) {
+	assert.Equal(t, true, IsWorkdayFor(time.Date(2024, 3, 8, 0, 0, 0, 0, ChinaStandardTime), Women))
+	assert.Equal(t, false, IsWorkdayFor(time.Date(2023, 6, 1, 0, 0, 0, 0, ChinaStandardTime), Children))
+	assert.Equal(t, true, IsWorkdayFor(time.Date(2023, 6, 1, 0, 0, 0, 0, ChinaStandardTime), Women))
+	assert.Equal(t, false, IsWorkdayFor(time.Date(2030, 3, 8, 0, 0, 0, 0, ChinaStandardTime), Women))
+}
+
+func TestCountWorkdaysFor(t *testing.T) {
+	start, end := time.Date(2024, 3, 1, 0, 0, 0, 0, ChinaStandardTime), time.Date(2024, 8, 31, 0, 0, 0, 0, ChinaStandardTime)
+	workdays, err := CountWorkdays(start, end)
+	assert.NoError(t, err)
+
+	days, err := CountWorkdaysFor(start, end, Women)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(workdays)-0.5, days)
+	days, _ = CountWorkdaysFor(start, end, Women|Military)
+	assert.Equal(t, float64(workdays)-1, days)
+	days, _ = CountWorkdaysFor(start, end, Youth)
+	assert.Equal(t, float64(workdays), days)
+	days, _ = CountWorkdaysFor(start, time.Date(2024, 8, 1, 0, 0, 0, 0, ChinaStandardTime), Military, HalfOpen)
+	assert.Equal(t, float64(workdays-22), days)
+
+	_, err = CountWorkdaysFor(start, time.Date(2030, 1, 1, 0, 0, 0, 0, ChinaStandardTime), Women)
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+}
+
+func TestGetHolidayDetailFor(t *testing.T) {
+	womensDay := time.Date(2024, 3, 8, 0, 0, 0, 0, ChinaStandardTime)
+	h, ok := GetHolidayDetailFor(womensDay, Women|Military)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, WomensDay, h)
+	_, ok = GetHolidayDetailFor(womensDay, Youth)
+	assert.Equal(t, false, ok)
+
+	h, ok = GetHolidayDetailFor(time.Date(2023, 6, 1, 0, 0, 0, 0, ChinaStandardTime), Children)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, InternationalChildrensDay, h)
+	assert.NotEqual(t, ChildrensDay, h)
+
+	// 适逢休息日时与 GetHolidayDetail 相同，2024 年 5 月 4 日为劳动节假期
+	h, ok = GetHolidayDetailFor(time.Date(2024, 5, 4, 0, 0, 0, 0, ChinaStandardTime), Youth)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, LabourDay, h)
+	h, ok = GetHolidayDetailFor(time.Date(2024, 10, 1, 0, 0, 0, 0, ChinaStandardTime), Military)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, NationalDay, h)
+
+	_, ok = GetHolidayDetailFor(time.Date(2030, 3, 8, 0, 0, 0, 0, ChinaStandardTime), Women)
+	assert.Equal(t, false, ok)
+}
+
+func TestGroupString(t *testing.T) {
+	assert.Equal(t, "妇女|现役军人", (Women | Military).String())
+	assert.Equal(t, "", Group(0).String())
+	assert.Equal(t, "半天", WorkHalfDay.String())
+	assert.Equal(t, 0.5, WorkHalfDay.Days())
+	assert.Equal(t, 1.0, WorkFullDay.Days())
+}
+
+// TestGroupHolidaysRegion 部分公民放假的节日只适用于大陆的日历
+func TestGroupHolidaysRegion(t *testing.T) {
+	womensDay := time.Date(2024, 3, 8, 0, 0, 0, 0, ChinaStandardTime)
+	armyDay := time.Date(2024, 8, 1, 0, 0, 0, 0, ChinaStandardTime)
+	tests := []struct {
+		region Region
+		day    time.Time
+		group  Group
+	}{
+		{HongKong, womensDay, Women},
+		{Macao, womensDay, Women},
+		{Taiwan, armyDay, Military},
+	}
+	for _, tt := range tests {
+		c, err := RegionCalendar(tt.region)
+		assert.NoError(t, err)
+		attendance, err := c.AttendanceFor(tt.day, tt.group)
+		assert.NoError(t, err)
+		assert.Equal(t, WorkFullDay, attendance, tt.region)
+		_, ok := c.GetHolidayDetailFor(tt.day, tt.group)
+		assert.Equal(t, false, ok, tt.region)
+		workdays, err := c.CountWorkdays(tt.day, tt.day)
+		assert.NoError(t, err)
+		days, err := c.CountWorkdaysFor(tt.day, tt.day, tt.group)
+		assert.NoError(t, err)
+		assert.Equal(t, float64(workdays), days, tt.region)
+
+		overlaid, err := c.WithOverlays(NewOverlay())
+		assert.NoError(t, err)
+		attendance, _ = overlaid.AttendanceFor(tt.day, tt.group)
+		assert.Equal(t, WorkFullDay, attendance, tt.region)
+	}
+
+	// 叠加地方节日、调整后的大陆日历仍然适用
+	c, err := ProvinceCalendar(Guangxi)
+	assert.NoError(t, err)
+	c, err = c.WithOverlays(NewOverlay())
+	assert.NoError(t, err)
+	attendance, err := c.AttendanceFor(womensDay, Women)
+	assert.NoError(t, err)
+	assert.Equal(t, WorkHalfDay, attendance)
+}
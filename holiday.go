@@ -3,24 +3,83 @@ package chinesecalendar
 import "time"
 
 var (
-	dateFormatYYYYMMDD = "2006-01-02"
-	oneDay             = 24 * time.Hour
-
-	// 节假日定义
-	NewYearsDay        = Holiday{"New Year's Day", "元旦", 1}
-	SpringFestival     = Holiday{"Spring Festival", "春节", 3}
-	TombSweepingDay    = Holiday{"Tomb-sweeping Day", "清明", 1}
-	LabourDay          = Holiday{"Labour Day", "劳动节", 1}
-	DragonBoatFestival = Holiday{"Dragon Boat Festival", "端午", 1}
-	NationalDay        = Holiday{"National Day", "国庆节", 3}
-	MidAutumnFestival  = Holiday{"Mid-autumn Festival", "中秋", 1}
-	AntiFascist70thDay = Holiday{"Anti-Fascist 70th Day", "中国人民抗日战争暨世界反法西斯战争胜利70周年纪念日", 1}
+	oneDay = 24 * time.Hour
+
+	// 节假日定义，天数为现行《全国年节及纪念日放假办法》规定的法定假日天数
+	NewYearsDay        = Holiday{"New Year's Day", "元旦", 1, NationalScope}
+	SpringFestival     = Holiday{"Spring Festival", "春节", 4, NationalScope}
+	TombSweepingDay    = Holiday{"Tomb-sweeping Day", "清明", 1, NationalScope}
+	LabourDay          = Holiday{"Labour Day", "劳动节", 2, NationalScope}
+	DragonBoatFestival = Holiday{"Dragon Boat Festival", "端午", 1, NationalScope}
+	NationalDay        = Holiday{"National Day", "国庆节", 3, NationalScope}
+	MidAutumnFestival  = Holiday{"Mid-autumn Festival", "中秋", 1, NationalScope}
+	AntiFascist70thDay = Holiday{"Anti-Fascist 70th Day", "中国人民抗日战争暨世界反法西斯战争胜利70周年纪念日", 0, NationalScope}
 )
 
+// statutoryDaysUntil 截至 until 年（含）法定假日为 days 天
+type statutoryDaysUntil struct {
+	until int
+	days  int
+}
+
+// statutoryDaysHistory 内置节日法定假日天数的变更历史，按年份排列，晚于最后一项的年份使用 Holiday.days。
+// 以 Holiday 为键，英文名相同的自定义节日不受影响
+//   - 1999 年修订：国庆节由 2 天改为 3 天，劳动节自 2000 年起由 1 天改为 3 天
+//   - 2007 年修订：自 2008 年起劳动节改为 1 天，增设清明节、端午节、中秋节各 1 天
+//   - 2024 年修订：自 2025 年起春节增加 1 天（除夕）、劳动节增加 1 天（5 月 2 日）
+//   - 2015 年 9 月 3 日为抗战胜利 70 周年纪念日放假 1 天
+var statutoryDaysHistory = map[Holiday][]statutoryDaysUntil{
+	SpringFestival:     {{2024, 3}},
+	TombSweepingDay:    {{2007, 0}},
+	LabourDay:          {{1999, 1}, {2007, 3}, {2024, 1}},
+	DragonBoatFestival: {{2007, 0}},
+	NationalDay:        {{1998, 2}},
+	MidAutumnFestival:  {{2007, 0}},
+	AntiFascist70thDay: {{2014, 0}, {2015, 1}},
+}
+
+// NewHoliday 创建自定义节日，days 为法定假日天数，不是法定节日时为 0
+func NewHoliday(engName, name string, days int) Holiday {
+	return Holiday{engName, name, days, NationalScope}
+}
+
+// HolidayScope 节日的适用范围
+type HolidayScope int
+
+const (
+	// NationalScope 全国性的节日，香港、澳门、台湾适用于全地区的公众假期也属于此类
+	NationalScope HolidayScope = iota
+	// ProvincialScope 省、自治区规定的地方节日，见 Calendar.WithProvince
+	ProvincialScope
+)
+
+var holidayScopeNames = [...]string{"national", "provincial"}
+
+func (s HolidayScope) String() string {
+	if s < 0 || int(s) >= len(holidayScopeNames) {
+		return "unknown"
+	}
+	return holidayScopeNames[s]
+}
+
+// parseHolidayScope 解析 HolidayScope.String 的结果，空字符串为 NationalScope
+func parseHolidayScope(s string) (HolidayScope, bool) {
+	if s == "" {
+		return NationalScope, true
+	}
+	for i, name := range holidayScopeNames {
+		if name == s {
+			return HolidayScope(i), true
+		}
+	}
+	return NationalScope, false
+}
+
 type Holiday struct {
 	engName string
 	name    string
 	days    int
+	scope   HolidayScope
 }
 
 func (h *Holiday) Name() string {
@@ -30,3 +89,24 @@ func (h *Holiday) Name() string {
 func (h *Holiday) EngName() string {
 	return h.engName
 }
+
+// Scope 节日的适用范围
+func (h *Holiday) Scope() HolidayScope {
+	return h.scope
+}
+
+// Days 现行规定的法定假日天数
+func (h *Holiday) Days() int {
+	return h.days
+}
+
+// StatutoryDays 某一年的法定假日天数（法定假日期间加班支付 300% 工资），
+// 放假安排中其余的日期是调休或周末
+func (h *Holiday) StatutoryDays(year int) int {
+	for _, change := range statutoryDaysHistory[*h] {
+		if year <= change.until {
+			return change.days
+		}
+	}
+	return h.days
+}
@@ -0,0 +1,36 @@
+package chinesecalendar
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatutoryDays(t *testing.T) {
+	args := []struct {
+		holiday Holiday
+		year    int
+		expect  int
+	}{
+		{NewYearsDay, 2004, 1},
+		{SpringFestival, 2024, 3},
+		{SpringFestival, 2025, 4},
+		{LabourDay, 1999, 1},
+		{LabourDay, 2004, 3},
+		{LabourDay, 2008, 1},
+		{LabourDay, 2025, 2},
+		{TombSweepingDay, 2007, 0},
+		{TombSweepingDay, 2008, 1},
+		{NationalDay, 1998, 2},
+		{NationalDay, 2024, 3},
+		{AntiFascist70thDay, 2015, 1},
+		{AntiFascist70thDay, 2016, 0},
+		// 英文名与内置节日相同的自定义节日不使用内置节日的变更历史
+		{NewHoliday("Spring Festival", "公司春节", 5), 2010, 5},
+		{NewHoliday("Labour Day", "五一", 3), 2008, 3},
+	}
+	for _, arg := range args {
+		assert.Equal(t, arg.expect, arg.holiday.StatutoryDays(arg.year), arg.holiday.Name())
+	}
+	assert.Equal(t, 4, SpringFestival.Days())
+}
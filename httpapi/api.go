@@ -0,0 +1,280 @@
+package httpapi
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/wangzeping722/chinesecalendar"
+)
+
+const dateLayout = "2006-01-02"
+
+// dayTypeKeys DayType 在接口中的标识
+var dayTypeKeys = map[chinesecalendar.DayType]string{
+	chinesecalendar.Workday:          "workday",
+	chinesecalendar.Weekend:          "weekend",
+	chinesecalendar.StatutoryHoliday: "statutory_holiday",
+	chinesecalendar.AdjustedRestDay:  "adjusted_rest_day",
+	chinesecalendar.AdjustedWorkday:  "adjusted_workday",
+}
+
+type holidayJSON struct {
+	Name    string `json:"name"`
+	EngName string `json:"engName"`
+}
+
+type dayJSON struct {
+	Date        string       `json:"date"`
+	Weekday     string       `json:"weekday"`
+	Workday     bool         `json:"workday"`
+	Holiday     bool         `json:"holiday"`
+	InLieu      bool         `json:"inLieu"`
+	DayType     string       `json:"dayType"`
+	DayTypeName string       `json:"dayTypeName"`
+	HolidayInfo *holidayJSON `json:"holidayInfo,omitempty"`
+	Projected   bool         `json:"projected"`
+}
+
+type rangeJSON struct {
+	Start string   `json:"start"`
+	End   string   `json:"end"`
+	Kind  string   `json:"kind"`
+	Count int      `json:"count"`
+	Dates []string `json:"dates"`
+}
+
+type countJSON struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Interval string `json:"interval"`
+	Workdays int    `json:"workdays"`
+	RestDays int    `json:"restDays"`
+}
+
+type addWorkdaysJSON struct {
+	Date   string `json:"date"`
+	N      int    `json:"n"`
+	Result string `json:"result"`
+}
+
+type periodJSON struct {
+	Start         string        `json:"start"`
+	End           string        `json:"end"`
+	Days          int           `json:"days"`
+	Holidays      []holidayJSON `json:"holidays"`
+	Workdays      []string      `json:"workdays"`
+	InLieuDays    []string      `json:"inLieuDays"`
+	StatutoryDays []string      `json:"statutoryDays"`
+}
+
+type versionJSON struct {
+	Version   string `json:"version"`
+	Projected bool   `json:"projected"`
+}
+
+func formatDates(list []time.Time) []string {
+	dates := make([]string, 0, len(list))
+	for _, t := range list {
+		dates = append(dates, t.Format(dateLayout))
+	}
+	return dates
+}
+
+func newPeriodJSON(p chinesecalendar.HolidayPeriod) periodJSON {
+	holidays := make([]holidayJSON, 0, len(p.Holidays))
+	for _, holiday := range p.Holidays {
+		holidays = append(holidays, holidayJSON{holiday.Name(), holiday.EngName()})
+	}
+	return periodJSON{
+		Start:         p.Start.Format(dateLayout),
+		End:           p.End.Format(dateLayout),
+		Days:          p.Days(),
+		Holidays:      holidays,
+		Workdays:      formatDates(p.Workdays),
+		InLieuDays:    formatDates(p.InLieuDays),
+		StatutoryDays: formatDates(p.StatutoryDays),
+	}
+}
+
+// dateParam 读取日期参数，按中国标准时间解析
+func dateParam(r *http.Request, name string) (time.Time, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return time.Time{}, invalidArgument("missing parameter %q", name)
+	}
+	t, err := time.ParseInLocation(dateLayout, value, chinesecalendar.ChinaStandardTime)
+	if err != nil {
+		return time.Time{}, invalidArgument("invalid %s %q, expected YYYY-MM-DD", name, value)
+	}
+	return t, nil
+}
+
+func intParam(r *http.Request, name string) (int, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, invalidArgument("missing parameter %q", name)
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, invalidArgument("invalid %s %q, expected an integer", name, value)
+	}
+	return n, nil
+}
+
+// boolParam 读取布尔参数，没有时返回 defaultValue
+func boolParam(r *http.Request, name string, defaultValue bool) (bool, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, invalidArgument("invalid %s %q, expected true or false", name, value)
+	}
+	return b, nil
+}
+
+func rangeParams(r *http.Request) (time.Time, time.Time, error) {
+	start, err := dateParam(r, "start")
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	end, err := dateParam(r, "end")
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if end.Before(start) {
+		return time.Time{}, time.Time{}, invalidArgument("end %s is before start %s", end.Format(dateLayout), start.Format(dateLayout))
+	}
+	return start, end, nil
+}
+
+func (h *Handler) day(r *http.Request) (interface{}, error) {
+	t, err := dateParam(r, "date")
+	if err != nil {
+		return nil, err
+	}
+	dayType, err := h.calendar.GetDayType(t)
+	if err != nil {
+		return nil, err
+	}
+
+	day := dayJSON{
+		Date:        t.Format(dateLayout),
+		Weekday:     t.Weekday().String(),
+		Workday:     h.calendar.IsWorkday(t),
+		Holiday:     h.calendar.IsHoliday(t),
+		InLieu:      h.calendar.IsInLieu(t),
+		DayType:     dayTypeKeys[dayType],
+		DayTypeName: dayType.String(),
+		Projected:   h.calendar.Projected(),
+	}
+	if holiday, _ := h.calendar.GetHolidayDetail(t); holiday.Name() != "" {
+		day.HolidayInfo = &holidayJSON{holiday.Name(), holiday.EngName()}
+	}
+	return day, nil
+}
+
+func (h *Handler) dateRange(r *http.Request) (interface{}, error) {
+	start, end, err := rangeParams(r)
+	if err != nil {
+		return nil, err
+	}
+	weekends, err := boolParam(r, "weekends", true)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := r.URL.Query().Get("kind")
+	var list []time.Time
+	switch kind {
+	case "workdays":
+		list, err = h.calendar.GetWorkdays(start, end)
+	case "holidays":
+		list, err = h.calendar.GetHolidays(start, end, weekends)
+	default:
+		return nil, invalidArgument("invalid kind %q, expected workdays or holidays", kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rangeJSON{start.Format(dateLayout), end.Format(dateLayout), kind, len(list), formatDates(list)}, nil
+}
+
+func (h *Handler) count(r *http.Request) (interface{}, error) {
+	start, end, err := rangeParams(r)
+	if err != nil {
+		return nil, err
+	}
+	interval, name := chinesecalendar.Closed, r.URL.Query().Get("interval")
+	switch name {
+	case "", "closed":
+		name = "closed"
+	case "half-open":
+		interval = chinesecalendar.HalfOpen
+	default:
+		return nil, invalidArgument("invalid interval %q, expected closed or half-open", name)
+	}
+
+	workdays, err := h.calendar.CountWorkdays(start, end, interval)
+	if err != nil {
+		return nil, err
+	}
+	restDays, err := h.calendar.CountHolidays(start, end, true, interval)
+	if err != nil {
+		return nil, err
+	}
+	return countJSON{start.Format(dateLayout), end.Format(dateLayout), name, workdays, restDays}, nil
+}
+
+func (h *Handler) addWorkdays(r *http.Request) (interface{}, error) {
+	t, err := dateParam(r, "date")
+	if err != nil {
+		return nil, err
+	}
+	n, err := intParam(r, "n")
+	if err != nil {
+		return nil, err
+	}
+	result, err := h.calendar.AddWorkdays(t, n)
+	if err != nil {
+		return nil, err
+	}
+	return addWorkdaysJSON{t.Format(dateLayout), n, result.Format(dateLayout)}, nil
+}
+
+func (h *Handler) period(r *http.Request) (interface{}, error) {
+	t, err := dateParam(r, "date")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.calendar.GetDayType(t); err != nil {
+		return nil, err
+	}
+	p, ok := h.calendar.GetHolidayPeriod(t)
+	if !ok {
+		return nil, &apiError{http.StatusNotFound, "not_found", t.Format(dateLayout) + " is not in a holiday period"}
+	}
+	return newPeriodJSON(p), nil
+}
+
+func (h *Handler) periods(r *http.Request) (interface{}, error) {
+	year, err := intParam(r, "year")
+	if err != nil {
+		return nil, err
+	}
+	list, err := h.calendar.GetHolidayPeriods(year)
+	if err != nil {
+		return nil, err
+	}
+	periods := make([]periodJSON, 0, len(list))
+	for _, p := range list {
+		periods = append(periods, newPeriodJSON(p))
+	}
+	return periods, nil
+}
+
+func (h *Handler) version(r *http.Request) (interface{}, error) {
+	return versionJSON{h.calendar.Version(), h.calendar.Projected()}, nil
+}
@@ -0,0 +1,159 @@
+// Package httpapi 以 HTTP JSON 接口提供节假日查询，供其他语言的服务调用。
+//
+// Handler 实现了 http.Handler，可以挂载到已有的服务上：
+//
+//	mux.Handle("/calendar/", http.StripPrefix("/calendar", httpapi.NewHandler(chinesecalendar.Default())))
+//
+// 接口只接受 GET、HEAD 请求，日期格式为 2006-01-02：
+//
+//	GET /day?date=2024-10-01                          某一天的信息
+//	GET /range?start=2024-10-01&end=2024-10-31&kind=workdays   区间内的工作日，kind=holidays 为节假日，weekends=false 时不含普通周末
+//	GET /count?start=2024-01-01&end=2024-12-31        区间内的工作日、休息日天数，interval=half-open 时不含 end
+//	GET /add-workdays?date=2024-09-30&n=5             第 n 个工作日，n 可以为负数
+//	GET /period?date=2024-10-03                       所在的放假区间
+//	GET /periods?year=2024                            某一年的全部放假区间
+//	GET /version                                      数据版本
+//
+// /day 与 /version 的 projected 表示数据是否为推算的结果（见 chinesecalendar.ProjectCalendar），而不是正式公布的安排。
+//
+// 出错时返回 {"error": "...", "code": "..."}：参数错误为 400 invalid_argument，
+// 日期不在数据范围内为 422 unsupported_date，不在放假区间内或路径不存在为 404 not_found，错误响应不允许缓存。
+// 成功的响应只由数据与请求参数决定，ETag 为数据版本（Calendar.Version），支持 If-None-Match 返回 304
+package httpapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/wangzeping722/chinesecalendar"
+)
+
+// DefaultMaxAge Cache-Control 的默认 max-age
+const DefaultMaxAge = time.Hour
+
+// Handler 节假日查询的 HTTP 接口
+type Handler struct {
+	// MaxAge 响应的缓存时间，为 0 时不设置 Cache-Control
+	MaxAge time.Duration
+
+	calendar *chinesecalendar.Calendar
+	mux      *http.ServeMux
+}
+
+// NewHandler 创建查询 c 的 HTTP 接口，MaxAge 为 DefaultMaxAge
+func NewHandler(c *chinesecalendar.Calendar) *Handler {
+	h := &Handler{MaxAge: DefaultMaxAge, calendar: c, mux: http.NewServeMux()}
+	h.mux.HandleFunc("/day", h.handle(h.day))
+	h.mux.HandleFunc("/range", h.handle(h.dateRange))
+	h.mux.HandleFunc("/count", h.handle(h.count))
+	h.mux.HandleFunc("/add-workdays", h.handle(h.addWorkdays))
+	h.mux.HandleFunc("/period", h.handle(h.period))
+	h.mux.HandleFunc("/periods", h.handle(h.periods))
+	h.mux.HandleFunc("/version", h.handle(h.version))
+	h.mux.HandleFunc("/", h.notFound)
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// apiError 带 HTTP 状态码的错误
+type apiError struct {
+	status int
+	code   string
+	msg    string
+}
+
+func (e *apiError) Error() string {
+	return e.msg
+}
+
+func invalidArgument(format string, args ...interface{}) error {
+	return &apiError{http.StatusBadRequest, "invalid_argument", fmt.Sprintf(format, args...)}
+}
+
+// toAPIError 将 chinesecalendar 返回的错误转换为 apiError
+func toAPIError(err error) *apiError {
+	var e *apiError
+	switch {
+	case errors.As(err, &e):
+		return e
+	case errors.Is(err, chinesecalendar.ErrUnSupportDate):
+		return &apiError{http.StatusUnprocessableEntity, "unsupported_date", err.Error()}
+	}
+	return &apiError{http.StatusInternalServerError, "internal", err.Error()}
+}
+
+// handle 处理请求方法、缓存与 JSON 编码，fn 返回响应内容。
+// 只有成功的响应带 ETag 与 Cache-Control，并按 If-None-Match 返回 304；出错时不允许缓存
+func (h *Handler) handle(fn func(r *http.Request) (interface{}, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			writeError(w, r, &apiError{http.StatusMethodNotAllowed, "method_not_allowed", "method not allowed"})
+			return
+		}
+
+		body, err := fn(r)
+		if err != nil {
+			writeError(w, r, toAPIError(err))
+			return
+		}
+
+		etag := `"` + h.calendar.Version() + `"`
+		w.Header().Set("ETag", etag)
+		if h.MaxAge > 0 {
+			w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(h.MaxAge/time.Second)))
+		}
+		if matchETag(r.Header.Get("If-None-Match"), etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		writeJSON(w, r, http.StatusOK, body)
+	}
+}
+
+// notFound 处理未知的路径
+func (h *Handler) notFound(w http.ResponseWriter, r *http.Request) {
+	writeError(w, r, &apiError{http.StatusNotFound, "not_found", fmt.Sprintf("unknown path %q", r.URL.Path)})
+}
+
+// matchETag 检查 If-None-Match 是否包含 etag，支持多个值、弱 ETag 与 *
+func matchETag(header, etag string) bool {
+	for _, value := range strings.Split(header, ",") {
+		value = strings.TrimPrefix(strings.TrimSpace(value), "W/")
+		if value == etag || value == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+func errorBody(e *apiError) interface{} {
+	return map[string]string{"error": e.msg, "code": e.code}
+}
+
+// writeError 返回错误，错误响应不允许缓存
+func writeError(w http.ResponseWriter, r *http.Request, e *apiError) {
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, r, e.status, errorBody(e))
+}
+
+func writeJSON(w http.ResponseWriter, r *http.Request, status int, body interface{}) {
+	buffer := &bytes.Buffer{}
+	if err := json.NewEncoder(buffer).Encode(body); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if r.Method != http.MethodHead {
+		w.Write(buffer.Bytes())
+	}
+}
@@ -0,0 +1,171 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wangzeping722/chinesecalendar"
+)
+
+func get(h http.Handler, target string, header ...string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, target, nil)
+	for i := 0; i+1 < len(header); i += 2 {
+		r.Header.Set(header[i], header[i+1])
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func decode(t *testing.T, w *httptest.ResponseRecorder) map[string]interface{} {
+	var body map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	return body
+}
+
+func TestDay(t *testing.T) {
+	h := NewHandler(chinesecalendar.Default())
+	w := get(h, "/day?date=2024-10-01")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"date": "2024-10-01", "weekday": "Tuesday", "workday": false, "holiday": true, "inLieu": false,
+		"dayType": "statutory_holiday", "dayTypeName": "法定假日", "holidayInfo": {"name": "国庆节", "engName": "National Day"}, "projected": false}`, w.Body.String())
+
+	w = get(h, "/day?date=2024-10-12")
+	assert.JSONEq(t, `{"date": "2024-10-12", "weekday": "Saturday", "workday": true, "holiday": false, "inLieu": false,
+		"dayType": "adjusted_workday", "dayTypeName": "调休上班", "projected": false}`, w.Body.String())
+}
+
+func TestRangeAndCount(t *testing.T) {
+	h := NewHandler(chinesecalendar.Default())
+	w := get(h, "/range?start=2024-10-01&end=2024-10-12&kind=workdays")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"start": "2024-10-01", "end": "2024-10-12", "kind": "workdays", "count": 5,
+		"dates": ["2024-10-08", "2024-10-09", "2024-10-10", "2024-10-11", "2024-10-12"]}`, w.Body.String())
+
+	w = get(h, "/range?start=2024-09-28&end=2024-10-01&kind=holidays&weekends=false")
+	assert.JSONEq(t, `{"start": "2024-09-28", "end": "2024-10-01", "kind": "holidays", "count": 1, "dates": ["2024-10-01"]}`, w.Body.String())
+
+	w = get(h, "/count?start=2024-10-01&end=2024-10-08&interval=half-open")
+	assert.JSONEq(t, `{"start": "2024-10-01", "end": "2024-10-08", "interval": "half-open", "workdays": 0, "restDays": 7}`, w.Body.String())
+
+	w = get(h, "/add-workdays?date=2024-10-08&n=-3")
+	assert.JSONEq(t, `{"date": "2024-10-08", "n": -3, "result": "2024-09-27"}`, w.Body.String())
+}
+
+func TestPeriods(t *testing.T) {
+	h := NewHandler(chinesecalendar.Default())
+	w := get(h, "/period?date=2023-10-01")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"start": "2023-09-29", "end": "2023-10-06", "days": 8,
+		"holidays": [{"name": "中秋", "engName": "Mid-autumn Festival"}, {"name": "国庆节", "engName": "National Day"}],
+		"workdays": ["2023-10-07", "2023-10-08"], "inLieuDays": ["2023-10-05", "2023-10-06"],
+		"statutoryDays": ["2023-09-29", "2023-10-01", "2023-10-02", "2023-10-03"]}`, w.Body.String())
+
+	w = get(h, "/period?date=2023-10-09")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "not_found", decode(t, w)["code"])
+
+	w = get(h, "/periods?year=2023")
+	var periods []map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &periods))
+	assert.Equal(t, 6, len(periods))
+	assert.Equal(t, "2022-12-31", periods[0]["start"])
+}
+
+func TestErrors(t *testing.T) {
+	h := NewHandler(chinesecalendar.Default())
+	tests := []struct {
+		target string
+		status int
+		code   string
+	}{
+		{"/day", http.StatusBadRequest, "invalid_argument"},
+		{"/day?date=2024/10/01", http.StatusBadRequest, "invalid_argument"},
+		{"/day?date=2030-01-01", http.StatusUnprocessableEntity, "unsupported_date"},
+		{"/range?start=2024-10-01&end=2024-10-13&kind=weekends", http.StatusBadRequest, "invalid_argument"},
+		{"/range?start=2024-10-13&end=2024-10-01&kind=workdays", http.StatusBadRequest, "invalid_argument"},
+		{"/count?start=2024-01-01&end=2030-01-01", http.StatusUnprocessableEntity, "unsupported_date"},
+		{"/count?start=2024-01-01&end=2024-02-01&interval=open", http.StatusBadRequest, "invalid_argument"},
+		{"/add-workdays?date=2024-10-08&n=x", http.StatusBadRequest, "invalid_argument"},
+		{"/add-workdays?date=2024-10-08&n=100", http.StatusUnprocessableEntity, "unsupported_date"},
+		{"/period?date=2030-10-01", http.StatusUnprocessableEntity, "unsupported_date"},
+		{"/periods?year=2030", http.StatusUnprocessableEntity, "unsupported_date"},
+		{"/days?date=2024-10-01", http.StatusNotFound, "not_found"},
+		{"/", http.StatusNotFound, "not_found"},
+	}
+	for _, tt := range tests {
+		w := get(h, tt.target)
+		assert.Equal(t, tt.status, w.Code, tt.target)
+		assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"), tt.target)
+		assert.Equal(t, "no-store", w.Header().Get("Cache-Control"), tt.target)
+		assert.Equal(t, "", w.Header().Get("ETag"), tt.target)
+		body := decode(t, w)
+		assert.Equal(t, tt.code, body["code"], tt.target)
+		assert.NotEmpty(t, body["error"], tt.target)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/day?date=2024-10-01", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(t, "GET, HEAD", w.Header().Get("Allow"))
+}
+
+func TestCaching(t *testing.T) {
+	h := NewHandler(chinesecalendar.Default())
+	etag := `"` + chinesecalendar.Default().Version() + `"`
+
+	w := get(h, "/day?date=2024-10-01")
+	assert.Equal(t, etag, w.Header().Get("ETag"))
+	assert.Equal(t, "public, max-age=3600", w.Header().Get("Cache-Control"))
+
+	w = get(h, "/day?date=2024-10-01", "If-None-Match", `"other", W/`+etag)
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Equal(t, 0, w.Body.Len())
+	w = get(h, "/day?date=2024-10-01", "If-None-Match", `"other"`)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// 出错的请求即使 ETag 相同也不返回 304
+	w = get(h, "/day?date=2030-01-01", "If-None-Match", etag)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+	w = get(h, "/day?date=x", "If-None-Match", "*")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = get(h, "/version")
+	assert.Equal(t, chinesecalendar.Default().Version(), decode(t, w)["version"])
+
+	// 数据不同时 ETag 不同
+	other, err := chinesecalendar.LoadJSONFile("../testdata/2030.json")
+	assert.NoError(t, err)
+	h = NewHandler(other)
+	h.MaxAge = 0
+	w = get(h, "/day?date=2030-02-09")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotEqual(t, etag, w.Header().Get("ETag"))
+	assert.Equal(t, "", w.Header().Get("Cache-Control"))
+}
+
+func TestMount(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("/calendar/", http.StripPrefix("/calendar", NewHandler(chinesecalendar.Default())))
+	w := get(mux, "/calendar/day?date=2024-10-01")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "2024-10-01", decode(t, w)["date"])
+}
+
+func TestProjected(t *testing.T) {
+	c, err := chinesecalendar.ProjectCalendar(2026, 2027)
+	assert.NoError(t, err)
+	h := NewHandler(c)
+	w := get(h, "/day?date=2026-02-17")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, true, decode(t, w)["projected"])
+	assert.Equal(t, true, decode(t, get(h, "/version"))["projected"])
+
+	assert.Equal(t, false, decode(t, get(NewHandler(chinesecalendar.Default()), "/version"))["projected"])
+}
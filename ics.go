@@ -0,0 +1,443 @@
+package chinesecalendar
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// iCalendar 中使用的自定义属性，保存 SUMMARY 之外的完整信息，日历软件会忽略这些属性
+const (
+	icsPropHoliday   = "X-CHINESECALENDAR-HOLIDAY"
+	icsPropType      = "X-CHINESECALENDAR-TYPE"
+	icsPropStatutory = "X-CHINESECALENDAR-STATUTORY"
+	icsPropInLieu    = "X-CHINESECALENDAR-INLIEU"
+	icsPropHalfDay   = "X-CHINESECALENDAR-HALFDAY"
+	// 内置节日以外的节日的定义
+	icsPropName    = "X-CHINESECALENDAR-NAME"
+	icsPropEngName = "X-CHINESECALENDAR-ENGNAME"
+	icsPropDays    = "X-CHINESECALENDAR-DAYS"
+	icsPropScope   = "X-CHINESECALENDAR-SCOPE"
+	// 日历的日期范围
+	icsPropStart = "X-CHINESECALENDAR-START"
+	icsPropEnd   = "X-CHINESECALENDAR-END"
+)
+
+const icsProdID = "-//wangzeping722//chinesecalendar//CN"
+
+// icsSummary 返回双语的事件标题，例如 "国庆节 休 / National Day (Day Off)"、"国庆节 班 / National Day (Working Day)"
+func icsSummary(a Arrangement) string {
+	switch {
+	case a.HalfDay:
+		return fmt.Sprintf("%s 休半天 / %s (Half Day Off)", a.Holiday.name, a.Holiday.engName)
+	case a.Workday:
+		return fmt.Sprintf("%s 班 / %s (Working Day)", a.Holiday.name, a.Holiday.engName)
+	case a.InLieu:
+		return fmt.Sprintf("%s 休 / %s (Day Off in Lieu)", a.Holiday.name, a.Holiday.engName)
+	default:
+		return fmt.Sprintf("%s 休 / %s (Day Off)", a.Holiday.name, a.Holiday.engName)
+	}
+}
+
+// icsUID 事件的 UID 由日期、节日标识与日历的标识（见 Calendar.feed）组成：
+// 同一日历重新导出时同一天的事件会覆盖而不是重复，不同地区、叠加了地方节日或自定义安排的日历之间不会冲突
+func icsUID(feed string, d civilDate, key string) string {
+	host := "chinesecalendar"
+	if feed != "" {
+		host = feed + "." + host
+	}
+	return fmt.Sprintf("%s-%s@%s", icsDate(d), key, host)
+}
+
+func icsDate(d civilDate) string {
+	return fmt.Sprintf("%04d%02d%02d", d.year, d.month, d.day)
+}
+
+// icsEscape 按 RFC 5545 3.3.11 转义文本
+func icsEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
+
+// icsWriter 按 RFC 5545 输出内容行：以 CRLF 结尾，超过 75 字节时折行
+type icsWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (iw *icsWriter) line(name, value string) {
+	if iw.err != nil {
+		return
+	}
+	s := name + ":" + value
+	limit := 75
+	for len(s) > limit {
+		n := limit
+		for !utf8.RuneStart(s[n]) {
+			n--
+		}
+		if _, iw.err = iw.w.WriteString(s[:n] + "\r\n "); iw.err != nil {
+			return
+		}
+		s = s[n:]
+		// 续行以空格开头，占一个字节
+		limit = 74
+	}
+	_, iw.err = iw.w.WriteString(s + "\r\n")
+}
+
+// WriteICS 将 [start, end] 内的放假安排写为 iCalendar（RFC 5545）数据，
+// 每个放假日、调休上班日一个全天事件，可导入 Outlook、Google 日历等。
+// 事件标题同时包含中英文节日名，以“休”“班”区分放假与调休上班，
+// UID 由日期、节日与日历（地区、地方节日、自定义安排）生成，再次导入同一日历时会更新原有事件，
+// 不同日历的事件互不冲突。
+// 日期不在支持范围内时返回 ErrUnSupportDate
+func (c *Calendar) WriteICS(w io.Writer, start, end time.Time) error {
+	startDay, endDay, err := c.validateRange(start, end)
+	if err != nil {
+		return err
+	}
+
+	iw := &icsWriter{w: bufio.NewWriter(w)}
+	iw.line("BEGIN", "VCALENDAR")
+	iw.line("VERSION", "2.0")
+	iw.line("PRODID", icsProdID)
+	iw.line("CALSCALE", "GREGORIAN")
+	iw.line("METHOD", "PUBLISH")
+	iw.line("X-WR-CALNAME", icsEscape("中国节假日"))
+	iw.line(icsPropStart, icsDate(startDay))
+	iw.line(icsPropEnd, icsDate(endDay))
+
+	stamp := time.Now().UTC().Format("20060102T150405Z")
+	keys := newHolidayKeys()
+	for _, a := range c.Arrangements() {
+		d := civilDateOf(a.Date)
+		if d.before(startDay) || d.after(endDay) {
+			continue
+		}
+		iw.line("BEGIN", "VEVENT")
+		key, _ := keys.key(a.Holiday)
+		iw.line("UID", icsUID(c.feed, d, key))
+		iw.line("DTSTAMP", stamp)
+		iw.line("DTSTART;VALUE=DATE", icsDate(d))
+		iw.line("DTEND;VALUE=DATE", icsDate(d.addDays(1)))
+		iw.line("SUMMARY", icsEscape(icsSummary(a)))
+		iw.line("TRANSP", "TRANSPARENT")
+		iw.line(icsPropHoliday, icsEscape(key))
+		if _, ok := builtinHoliday(key); !ok {
+			iw.line(icsPropName, icsEscape(a.Holiday.name))
+			iw.line(icsPropEngName, icsEscape(a.Holiday.engName))
+			iw.line(icsPropDays, fmt.Sprint(a.Holiday.days))
+			if a.Holiday.scope != NationalScope {
+				iw.line(icsPropScope, a.Holiday.scope.String())
+			}
+		}
+		if a.Workday {
+			iw.line(icsPropType, jsonTypeWorkday)
+		} else {
+			iw.line(icsPropType, jsonTypeHoliday)
+		}
+		if a.Statutory {
+			iw.line(icsPropStatutory, "TRUE")
+		}
+		if a.InLieu {
+			iw.line(icsPropInLieu, "TRUE")
+		}
+		if a.HalfDay {
+			iw.line(icsPropHalfDay, "TRUE")
+		}
+		iw.line("END", "VEVENT")
+	}
+	iw.line("END", "VCALENDAR")
+
+	if iw.err != nil {
+		return iw.err
+	}
+	return iw.w.Flush()
+}
+
+// ICSRule 根据事件标题判断放假安排：所属节日，以及是否为调休上班日，不是放假安排的事件返回 false
+type ICSRule func(summary string) (holiday Holiday, workday bool, ok bool)
+
+var (
+	// icsMarkers 标题中表示放假、上班的标记，较长的放在前面
+	icsMarkers = strings.NewReplacer(
+		"调休上班", "", "补班", "", "上班", "", "调休", "", "放假", "", "假期", "",
+		"（休）", "", "（班）", "", "(休)", "", "(班)", "", "【休】", "", "【班】", "",
+		"休", "", "班", "",
+	)
+	icsEngMarkers = strings.NewReplacer("(Day Off in Lieu)", "", "(Day Off)", "", "(Working Day)", "")
+)
+
+// DefaultICSRule 默认的标题识别规则：含“班”的为调休上班，含“休”或“假”的为放假，其余事件忽略。
+// 去掉标记后的标题含有内置节日名（如“国庆节”）时归入内置节日，否则作为自定义节日；
+// WriteICS 生成的“中文 / English”形式的标题会分别取中英文名
+func DefaultICSRule(summary string) (Holiday, bool, bool) {
+	name, engName := summary, ""
+	if i := strings.Index(summary, " / "); i >= 0 {
+		name, engName = summary[:i], summary[i+len(" / "):]
+	}
+
+	workday := false
+	switch {
+	case strings.Contains(name, "班"):
+		workday = true
+	case strings.ContainsAny(name, "休假"):
+	default:
+		return Holiday{}, false, false
+	}
+
+	name = strings.TrimSpace(icsMarkers.Replace(name))
+	for _, b := range builtinHolidays {
+		if name != "" && strings.Contains(name, b.holiday.name) {
+			return b.holiday, workday, true
+		}
+	}
+	if name == "" {
+		name = strings.TrimSpace(summary)
+	}
+	engName = strings.TrimSpace(icsEngMarkers.Replace(engName))
+	if engName == "" {
+		engName = name
+	}
+	return NewHoliday(engName, name, 0), workday, true
+}
+
+// icsProperty iCalendar 的一个内容行
+type icsProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// parseICSProperty 解析展开后的内容行，格式为 name *(";" param) ":" value
+func parseICSProperty(line string) (icsProperty, bool) {
+	quoted := false
+	colon := -1
+	for i := 0; i < len(line) && colon < 0; i++ {
+		switch line[i] {
+		case '"':
+			quoted = !quoted
+		case ':':
+			if !quoted {
+				colon = i
+			}
+		}
+	}
+	if colon < 0 {
+		return icsProperty{}, false
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	p := icsProperty{name: strings.ToUpper(parts[0]), params: make(map[string]string), value: line[colon+1:]}
+	for _, param := range parts[1:] {
+		if k := strings.IndexByte(param, '='); k >= 0 {
+			p.params[strings.ToUpper(param[:k])] = strings.Trim(param[k+1:], `"`)
+		}
+	}
+	return p, true
+}
+
+// icsUnescape 是 icsEscape 的逆运算
+func icsUnescape(s string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n").Replace(s)
+}
+
+// parseICSDate 取 DATE 或 DATE-TIME 值的日期部分，DATE-TIME 不做时区换算
+func parseICSDate(s string) (civilDate, error) {
+	if len(s) >= 8 {
+		if t, err := time.Parse("20060102", s[:8]); err == nil {
+			return civilDateOf(t), nil
+		}
+	}
+	return civilDate{}, fmt.Errorf("%w: invalid iCalendar date %q", ErrInvalidArrangement, s)
+}
+
+// parseICSDays 解析形如 P3D、P1W 的时长，返回天数
+func parseICSDays(s string) (int, error) {
+	var n int
+	var unit byte
+	if _, err := fmt.Sscanf(s, "P%d%c", &n, &unit); err == nil && n > 0 {
+		switch unit {
+		case 'D':
+			return n, nil
+		case 'W':
+			return n * 7, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: unsupported iCalendar duration %q", ErrInvalidArrangement, s)
+}
+
+// readICSLines 读取内容行并展开折行
+func readICSLines(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
+
+// icsEvent 一个 VEVENT 中用到的属性
+type icsEvent map[string]icsProperty
+
+// arrangements 将事件展开为每天一条放假安排，不是放假安排的事件返回空
+func (e icsEvent) arrangements(rule ICSRule) ([]Arrangement, error) {
+	dtstart, ok := e["DTSTART"]
+	if !ok {
+		return nil, fmt.Errorf("%w: VEVENT %q has no DTSTART", ErrInvalidArrangement, e["SUMMARY"].value)
+	}
+	holiday, workday, ok := rule(icsUnescape(e["SUMMARY"].value))
+	if !ok {
+		return nil, nil
+	}
+	if p, ok := e[icsPropHoliday]; ok {
+		if b, ok := builtinHoliday(icsUnescape(p.value)); ok {
+			holiday = b
+		}
+		if name, ok := e[icsPropName]; ok {
+			days, err := strconv.Atoi(e[icsPropDays].value)
+			if err != nil && e[icsPropDays].value != "" {
+				return nil, fmt.Errorf("%w: holiday %q has invalid days %q", ErrInvalidArrangement, p.value, e[icsPropDays].value)
+			}
+			scope, ok := parseHolidayScope(e[icsPropScope].value)
+			if !ok {
+				return nil, fmt.Errorf("%w: holiday %q has unknown scope %q", ErrInvalidArrangement, p.value, e[icsPropScope].value)
+			}
+			holiday = Holiday{icsUnescape(e[icsPropEngName].value), icsUnescape(name.value), days, scope}
+		}
+	}
+	if p, ok := e[icsPropType]; ok {
+		workday = p.value == jsonTypeWorkday
+	}
+
+	start, err := parseICSDate(dtstart.value)
+	if err != nil {
+		return nil, err
+	}
+	days := 1
+	if p, ok := e["DTEND"]; ok {
+		end, err := parseICSDate(p.value)
+		if err != nil {
+			return nil, err
+		}
+		// 全天事件的 DTEND 不包含在内，带时间的事件结束于当天
+		days = start.daysUntil(end)
+		if p.params["VALUE"] != "DATE" && len(p.value) > 8 && p.value[8:] != "T000000" && p.value[8:] != "T000000Z" {
+			days++
+		}
+		if days <= 0 {
+			days = 1
+		}
+	} else if p, ok := e["DURATION"]; ok {
+		if days, err = parseICSDays(p.value); err != nil {
+			return nil, err
+		}
+	}
+
+	list := make([]Arrangement, 0, days)
+	for i := 0; i < days; i++ {
+		list = append(list, Arrangement{
+			Date:      start.addDays(i).time(ChinaStandardTime),
+			Holiday:   holiday,
+			Workday:   workday,
+			Statutory: strings.EqualFold(e[icsPropStatutory].value, "TRUE"),
+			InLieu:    strings.EqualFold(e[icsPropInLieu].value, "TRUE"),
+			HalfDay:   strings.EqualFold(e[icsPropHalfDay].value, "TRUE"),
+		})
+	}
+	return list, nil
+}
+
+// LoadICS 从 iCalendar（RFC 5545）数据构建日历，rule 为 nil 时使用 DefaultICSRule 识别事件标题。
+// 每个事件覆盖的每一天（全天事件不含 DTEND 当天）为一条放假安排，重复的日期视为数据不合法。
+// WriteICS 生成的数据可以完整读回，包括自定义节日的法定假日天数与适用范围；
+// 其他数据的日期范围为事件所在年份的全年，法定假日、替代日信息为空
+func LoadICS(r io.Reader, rule ICSRule) (*Calendar, error) {
+	if rule == nil {
+		rule = DefaultICSRule
+	}
+	lines, err := readICSLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var arrangements []Arrangement
+	var start, end *civilDate
+	var event icsEvent
+	for _, line := range lines {
+		p, ok := parseICSProperty(line)
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid iCalendar line %q", ErrInvalidArrangement, line)
+		}
+		switch {
+		case p.name == "BEGIN" && strings.EqualFold(p.value, "VEVENT"):
+			event = make(icsEvent)
+		case p.name == "END" && strings.EqualFold(p.value, "VEVENT") && event != nil:
+			list, err := event.arrangements(rule)
+			if err != nil {
+				return nil, err
+			}
+			arrangements = append(arrangements, list...)
+			event = nil
+		case event != nil:
+			event[p.name] = p
+		case p.name == icsPropStart || p.name == icsPropEnd:
+			d, err := parseICSDate(p.value)
+			if err != nil {
+				return nil, err
+			}
+			if p.name == icsPropStart {
+				start = &d
+			} else {
+				end = &d
+			}
+		}
+	}
+	if len(arrangements) == 0 && (start == nil || end == nil) {
+		return nil, fmt.Errorf("%w: no holiday events found", ErrInvalidArrangement)
+	}
+
+	if start == nil {
+		first := civilDateOf(arrangements[0].Date)
+		for _, a := range arrangements {
+			if d := civilDateOf(a.Date); d.before(first) {
+				first = d
+			}
+		}
+		start = &civilDate{first.year, time.January, 1}
+	}
+	if end == nil {
+		last := civilDateOf(arrangements[0].Date)
+		for _, a := range arrangements {
+			if d := civilDateOf(a.Date); d.after(last) {
+				last = d
+			}
+		}
+		end = &civilDate{last.year, time.December, 31}
+	}
+	return NewCalendar(start.time(ChinaStandardTime), end.time(ChinaStandardTime), arrangements)
+}
+
+// LoadICSFile 从 iCalendar 文件构建日历，见 LoadICS
+func LoadICSFile(path string, rule ICSRule) (*Calendar, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return LoadICS(file, rule)
+}
@@ -0,0 +1,201 @@
+package chinesecalendar
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteICS(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	start := time.Date(2024, 9, 28, 0, 0, 0, 0, ChinaStandardTime)
+	end := time.Date(2024, 10, 12, 0, 0, 0, 0, ChinaStandardTime)
+	assert.NoError(t, WriteICS(buffer, start, end))
+	content := buffer.String()
+
+	assert.True(t, strings.HasPrefix(content, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(content, "END:VEVENT\r\nEND:VCALENDAR\r\n"))
+	// 国庆节 10 月 1 日至 7 日放假，9 月 29 日、10 月 12 日上班
+	assert.Equal(t, 9, strings.Count(content, "BEGIN:VEVENT\r\n"))
+	assert.Contains(t, content, "UID:20240929-national_day@mainland.chinesecalendar\r\n"+
+		"DTSTAMP:")
+	assert.Contains(t, content, "DTSTART;VALUE=DATE:20240929\r\n"+
+		"DTEND;VALUE=DATE:20240930\r\n"+
+		"SUMMARY:国庆节 班 / National Day (Working Day)\r\n")
+	assert.Contains(t, content, "DTSTART;VALUE=DATE:20241001\r\n"+
+		"DTEND;VALUE=DATE:20241002\r\n"+
+		"SUMMARY:国庆节 休 / National Day (Day Off)\r\n"+
+		"TRANSP:TRANSPARENT\r\n"+
+		"X-CHINESECALENDAR-HOLIDAY:national_day\r\n"+
+		"X-CHINESECALENDAR-TYPE:holiday\r\n"+
+		"X-CHINESECALENDAR-STATUTORY:TRUE\r\n")
+	assert.Contains(t, content, "SUMMARY:国庆节 休 / National Day (Day Off in Lieu)\r\n")
+
+	assert.ErrorIs(t, WriteICS(buffer, start, time.Date(2030, 1, 1, 0, 0, 0, 0, ChinaStandardTime)), ErrUnSupportDate)
+}
+
+// TestICSUID 不同日历同一天的事件 UID 不同
+func TestICSUID(t *testing.T) {
+	day := time.Date(2024, 10, 1, 0, 0, 0, 0, ChinaStandardTime)
+	hk, err := RegionCalendar(HongKong)
+	assert.NoError(t, err)
+	guangxi, err := ProvinceCalendar(Guangxi)
+	assert.NoError(t, err)
+	overlaid, err := guangxi.WithOverlays(NewOverlay().AddRestDay(day, NewHoliday("Anniversary", "司庆", 0)))
+	assert.NoError(t, err)
+	projected, err := ProjectCalendar(2024, 2024)
+	assert.NoError(t, err)
+	custom, err := NewCalendar(day, day, []Arrangement{{Date: day, Holiday: NationalDay, Statutory: true}})
+	assert.NoError(t, err)
+
+	expected := map[*Calendar]string{
+		Default(): "20241001-national_day@mainland.chinesecalendar",
+		hk:        "20241001-national_day@hongkong.chinesecalendar",
+		guangxi:   "20241001-national_day@mainland-guangxi.chinesecalendar",
+		overlaid:  "20241001-anniversary@mainland-guangxi-overlay.chinesecalendar",
+		projected: "20241001-national_day@projected.chinesecalendar",
+		custom:    "20241001-national_day@chinesecalendar",
+	}
+	for c, uid := range expected {
+		buffer := &bytes.Buffer{}
+		assert.NoError(t, c.WriteICS(buffer, day, day))
+		assert.Contains(t, buffer.String(), "\r\nUID:"+uid+"\r\n")
+	}
+}
+
+func TestICSFolding(t *testing.T) {
+	holiday := NewHoliday("Anniversary; Founders, Partners and Employees Day", "公司成立二十周年纪念日暨全体员工答谢日", 1)
+	day := time.Date(2030, 6, 6, 0, 0, 0, 0, ChinaStandardTime)
+	c, err := NewCalendar(day, day, []Arrangement{{Date: day, Holiday: holiday, Statutory: true}})
+	assert.NoError(t, err)
+
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, c.WriteICS(buffer, day, day))
+	lines := strings.Split(strings.TrimSuffix(buffer.String(), "\r\n"), "\r\n")
+	for _, line := range lines {
+		assert.LessOrEqual(t, len(line), 75, line)
+	}
+
+	// 去掉折行后恢复原内容
+	unfolded := strings.ReplaceAll(buffer.String(), "\r\n ", "")
+	assert.Contains(t, unfolded, `SUMMARY:公司成立二十周年纪念日暨全体员工答谢日 休 / Anniversary\; Founders\, Partners and Employees Day (Day Off)`+"\r\n")
+	assert.Contains(t, unfolded, "X-CHINESECALENDAR-HOLIDAY:anniversary_founders_partners_and_employees_day\r\n")
+}
+
+func TestICSRoundTrip(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, WriteICS(buffer, minDay.time(ChinaStandardTime), maxDay.time(ChinaStandardTime)))
+
+	c, err := LoadICS(buffer, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultCalendar.tables, c.tables)
+	assert.Equal(t, defaultCalendar.minDay, c.minDay)
+	assert.Equal(t, defaultCalendar.maxDay, c.maxDay)
+}
+
+// TestICSRoundTripCustomHolidays 自定义节日的法定假日天数、地方节日的适用范围可以完整读回
+func TestICSRoundTripCustomHolidays(t *testing.T) {
+	anniversary := NewHoliday("Anniversary; Day", "司庆，休", 1)
+	c, err := ProvinceCalendar(Guangxi)
+	assert.NoError(t, err)
+	springFestival := NewHoliday("Spring Festival", "春节", 3)
+	c, err = c.WithOverlays(NewOverlay().
+		AddRestDay(time.Date(2024, 5, 6, 0, 0, 0, 0, ChinaStandardTime), anniversary).
+		AddRestDay(time.Date(2024, 5, 7, 0, 0, 0, 0, ChinaStandardTime), springFestival))
+	assert.NoError(t, err)
+
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, c.WriteICS(buffer, time.Date(2024, 1, 1, 0, 0, 0, 0, ChinaStandardTime), time.Date(2024, 10, 12, 0, 0, 0, 0, ChinaStandardTime)))
+	loaded, err := LoadICS(buffer, nil)
+	assert.NoError(t, err)
+
+	holiday, _ := loaded.GetHolidayDetail(time.Date(2024, 5, 6, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, anniversary, holiday)
+	assert.Equal(t, 1, holiday.Days())
+	holiday, _ = loaded.GetHolidayDetail(time.Date(2024, 5, 7, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, springFestival, holiday)
+	holiday, _ = loaded.GetHolidayDetail(time.Date(2024, 4, 11, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, SanyuesanFestival, holiday)
+	assert.Equal(t, ProvincialScope, holiday.Scope())
+	dayType, err := loaded.GetDayType(time.Date(2024, 4, 11, 0, 0, 0, 0, ChinaStandardTime))
+	assert.NoError(t, err)
+	assert.Equal(t, StatutoryHoliday, dayType)
+
+	_, err = LoadICS(strings.NewReader("BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20300101\r\nSUMMARY:司庆 休\r\n"+
+		"X-CHINESECALENDAR-HOLIDAY:anniversary\r\nX-CHINESECALENDAR-NAME:司庆\r\nX-CHINESECALENDAR-SCOPE:county\r\n"+
+		"END:VEVENT\r\nEND:VCALENDAR\r\n"), nil)
+	assert.ErrorIs(t, err, ErrInvalidArrangement)
+}
+
+func TestLoadICSFile(t *testing.T) {
+	c, err := LoadICSFile("testdata/company.ics", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, civilDate{2030, time.January, 1}, c.minDay)
+	assert.Equal(t, civilDate{2030, time.December, 31}, c.maxDay)
+
+	holiday, isHoliday := c.GetHolidayDetail(time.Date(2030, 1, 1, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, true, isHoliday)
+	assert.Equal(t, NewYearsDay, holiday)
+	for d := 2; d <= 8; d++ {
+		holiday, isHoliday = c.GetHolidayDetail(time.Date(2030, 2, d, 0, 0, 0, 0, ChinaStandardTime))
+		assert.Equal(t, true, isHoliday)
+		assert.Equal(t, SpringFestival, holiday)
+	}
+	assert.Equal(t, true, c.IsWorkday(time.Date(2030, 2, 9, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.Contains(t, c.Arrangements(), Arrangement{Date: time.Date(2030, 2, 9, 0, 0, 0, 0, ChinaStandardTime), Holiday: SpringFestival, Workday: true})
+
+	// 带时间的事件覆盖开始、结束当天
+	for d := 6; d <= 7; d++ {
+		holiday, isHoliday = c.GetHolidayDetail(time.Date(2030, 6, d, 0, 0, 0, 0, ChinaStandardTime))
+		assert.Equal(t, true, isHoliday)
+		assert.Equal(t, "司庆", holiday.Name())
+		assert.Equal(t, "Company Anniversary", holiday.EngName())
+	}
+	// 不含“休”“班”的事件被忽略
+	assert.Equal(t, true, c.IsWorkday(time.Date(2030, 7, 15, 0, 0, 0, 0, ChinaStandardTime)))
+}
+
+func TestDefaultICSRule(t *testing.T) {
+	tests := []struct {
+		summary string
+		holiday Holiday
+		workday bool
+		ok      bool
+	}{
+		{"国庆节 休 / National Day (Day Off)", NationalDay, false, true},
+		{"国庆节 班 / National Day (Working Day)", NationalDay, true, true},
+		{"中秋节（休）", MidAutumnFestival, false, true},
+		{"清明节 补班", TombSweepingDay, true, true},
+		{"劳动节放假", LabourDay, false, true},
+		{"司庆 休", NewHoliday("司庆", "司庆", 0), false, true},
+		{"休", NewHoliday("休", "休", 0), false, true},
+		{"年会", Holiday{}, false, false},
+	}
+	for _, tt := range tests {
+		holiday, workday, ok := DefaultICSRule(tt.summary)
+		assert.Equal(t, tt.holiday, holiday, tt.summary)
+		assert.Equal(t, tt.workday, workday, tt.summary)
+		assert.Equal(t, tt.ok, ok, tt.summary)
+	}
+}
+
+func TestLoadICSCustomRule(t *testing.T) {
+	anniversary := NewHoliday("Anniversary", "司庆", 1)
+	rule := func(summary string) (Holiday, bool, bool) {
+		return anniversary, false, summary == "Company Day"
+	}
+	content := "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:20300606\nDURATION:P2D\nSUMMARY:Company Day\nEND:VEVENT\nEND:VCALENDAR\n"
+	c, err := LoadICS(strings.NewReader(content), rule)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(c.Arrangements()))
+	assert.Equal(t, true, c.IsHoliday(time.Date(2030, 6, 7, 0, 0, 0, 0, ChinaStandardTime)))
+
+	_, err = LoadICS(strings.NewReader("BEGIN:VCALENDAR\nEND:VCALENDAR\n"), nil)
+	assert.True(t, errors.Is(err, ErrInvalidArrangement))
+	_, err = LoadICS(strings.NewReader(strings.Repeat(content, 2)), rule)
+	assert.True(t, errors.Is(err, ErrInvalidArrangement))
+}
@@ -0,0 +1,38 @@
+package astro
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// within 检查 got 与 want 相差不超过一分钟
+func within(t *testing.T, want, got time.Time) {
+	t.Helper()
+	assert.WithinDuration(t, want, got, time.Minute)
+}
+
+func TestSolarLongitudeTime(t *testing.T) {
+	within(t, time.Date(2000, 3, 20, 7, 35, 0, 0, time.UTC), SolarLongitudeTime(2000, 0))
+	within(t, time.Date(2024, 3, 20, 3, 6, 0, 0, time.UTC), SolarLongitudeTime(2024, 0))
+	within(t, time.Date(2024, 4, 4, 7, 2, 0, 0, time.UTC), SolarLongitudeTime(2024, 15))
+	within(t, time.Date(2024, 12, 21, 9, 20, 0, 0, time.UTC), SolarLongitudeTime(2024, 270))
+	// 小寒在 1 月
+	within(t, time.Date(2024, 1, 5, 20, 49, 0, 0, time.UTC), SolarLongitudeTime(2024, 285))
+}
+
+func TestNewMoon(t *testing.T) {
+	within(t, time.Date(2000, 1, 6, 18, 14, 0, 0, time.UTC), NewMoon(0))
+	within(t, time.Date(2024, 2, 9, 22, 59, 0, 0, time.UTC), NewMoon(298))
+
+	assert.Equal(t, 297, NewMoonIndex(time.Date(2024, 2, 9, 22, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 298, NewMoonIndex(time.Date(2024, 2, 9, 23, 0, 0, 0, time.UTC)))
+	assert.Equal(t, -1, NewMoonIndex(time.Date(2000, 1, 6, 18, 0, 0, 0, time.UTC)))
+}
+
+func TestJulianDay(t *testing.T) {
+	assert.Equal(t, 2451545.0, JulianDay(time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)))
+	tm := time.Date(2024, 10, 1, 8, 30, 15, 0, time.UTC)
+	assert.Equal(t, tm, TimeOf(JulianDay(tm)))
+}
@@ -0,0 +1,105 @@
+package astro
+
+import (
+	"math"
+	"time"
+)
+
+// synodicMonth 平均朔望月长度（天）
+const synodicMonth = 29.530588861
+
+// newMoonTerm 朔的周期项：系数、E 的次数，以及 M、M′、F、Ω 的倍数
+type newMoonTerm struct {
+	coefficient float64
+	e           int
+	m, m1, f, o float64
+}
+
+// newMoonTerms Meeus 第 49 章朔的周期项
+var newMoonTerms = []newMoonTerm{
+	{-0.40720, 0, 0, 1, 0, 0},
+	{0.17241, 1, 1, 0, 0, 0},
+	{0.01608, 0, 0, 2, 0, 0},
+	{0.01039, 0, 0, 0, 2, 0},
+	{0.00739, 1, -1, 1, 0, 0},
+	{-0.00514, 1, 1, 1, 0, 0},
+	{0.00208, 2, 2, 0, 0, 0},
+	{-0.00111, 0, 0, 1, -2, 0},
+	{-0.00057, 0, 0, 1, 2, 0},
+	{0.00056, 1, 1, 2, 0, 0},
+	{-0.00042, 0, 0, 3, 0, 0},
+	{0.00042, 1, 1, 0, 2, 0},
+	{0.00038, 1, 1, 0, -2, 0},
+	{-0.00024, 1, -1, 2, 0, 0},
+	{-0.00017, 0, 0, 0, 0, 1},
+	{-0.00007, 0, 2, 1, 0, 0},
+	{0.00004, 0, 0, 2, -2, 0},
+	{0.00004, 0, 3, 0, 0, 0},
+	{0.00003, 0, 1, 1, -2, 0},
+	{0.00003, 0, 0, 2, 2, 0},
+	{-0.00003, 0, 1, 1, 2, 0},
+	{0.00003, 0, -1, 1, 2, 0},
+	{-0.00002, 0, -1, 1, -2, 0},
+	{-0.00002, 0, 1, 3, 0, 0},
+	{0.00002, 0, 0, 4, 0, 0},
+}
+
+// planetaryTerms Meeus 第 49 章的行星摄动项：A = a + b·k + c·T²，修正量为 coefficient·sin A
+var planetaryTerms = []struct {
+	a, b, c, coefficient float64
+}{
+	{299.77, 0.107408, -0.009173, 0.000325},
+	{251.88, 0.016321, 0, 0.000165},
+	{251.83, 26.651886, 0, 0.000164},
+	{349.42, 36.412478, 0, 0.000126},
+	{84.66, 18.206239, 0, 0.000110},
+	{141.74, 53.303771, 0, 0.000062},
+	{207.14, 2.453732, 0, 0.000060},
+	{154.84, 7.306860, 0, 0.000056},
+	{34.52, 27.261239, 0, 0.000047},
+	{207.19, 0.121824, 0, 0.000042},
+	{291.34, 1.844379, 0, 0.000040},
+	{161.72, 24.198154, 0, 0.000037},
+	{239.56, 25.513099, 0, 0.000035},
+	{331.55, 3.592518, 0, 0.000023},
+}
+
+// newMoonJDE 返回第 k 个朔（k = 0 为 2000 年 1 月 6 日的朔）的儒略历书日（TT）
+func newMoonJDE(k float64) float64 {
+	t := k / 1236.85
+	t2, t3, t4 := t*t, t*t*t, t*t*t*t
+
+	jde := 2451550.09766 + synodicMonth*k + 0.00015437*t2 - 0.000000150*t3 + 0.00000000073*t4
+	e := 1 - 0.002516*t - 0.0000074*t2
+	m := rad(2.5534 + 29.10535670*k - 0.0000014*t2 - 0.00000011*t3)
+	m1 := rad(201.5643 + 385.81693528*k + 0.0107582*t2 + 0.00001238*t3 - 0.000000058*t4)
+	f := rad(160.7108 + 390.67050284*k - 0.0016118*t2 - 0.00000227*t3 + 0.000000011*t4)
+	o := rad(124.7746 - 1.56375588*k + 0.0020672*t2 + 0.00000215*t3)
+
+	for _, term := range newMoonTerms {
+		jde += term.coefficient * math.Pow(e, float64(term.e)) * math.Sin(term.m*m+term.m1*m1+term.f*f+term.o*o)
+	}
+	for _, term := range planetaryTerms {
+		jde += term.coefficient * math.Sin(rad(term.a+term.b*k+term.c*t2))
+	}
+	return jde
+}
+
+// NewMoon 返回第 k 个朔的时刻（UTC），k = 0 为 2000 年 1 月 6 日的朔，k 为负数时在此之前
+func NewMoon(k int) time.Time {
+	return TimeOf(ttToUT(newMoonJDE(float64(k))))
+}
+
+// NewMoonIndex 返回 t 之前（含 t）最近一次朔的序号，见 NewMoon
+func NewMoonIndex(t time.Time) int {
+	jde := utToTT(JulianDay(t))
+	k := int(math.Floor((jde - 2451550.09766) / synodicMonth))
+	// 平朔与定朔最多相差十几个小时，前后调整
+	for newMoonJDE(float64(k+1)) <= jde {
+		k++
+	}
+	for newMoonJDE(float64(k)) > jde {
+		k--
+	}
+	return k
+}
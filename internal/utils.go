@@ -2,6 +2,7 @@ package chinesecalendar
 
 import "time"
 
+// Date 返回 UTC 时区的零点，按天递增时不受夏令时影响
 func Date(year, month, day int) time.Time {
-	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.Local)
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
 }
@@ -0,0 +1,312 @@
+package chinesecalendar
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// DataVersion JSON 数据格式的版本
+const DataVersion = 1
+
+// builtinHolidays 内置节日在 JSON 数据中的标识
+var builtinHolidays = []struct {
+	key     string
+	holiday Holiday
+}{
+	{"new_years_day", NewYearsDay},
+	{"spring_festival", SpringFestival},
+	{"tomb_sweeping_day", TombSweepingDay},
+	{"labour_day", LabourDay},
+	{"dragon_boat_festival", DragonBoatFestival},
+	{"national_day", NationalDay},
+	{"mid_autumn_festival", MidAutumnFestival},
+	{"anti_fascist_70th_day", AntiFascist70thDay},
+}
+
+// builtinHoliday 返回标识为 key 的内置节日
+func builtinHoliday(key string) (Holiday, bool) {
+	for _, b := range builtinHolidays {
+		if b.key == key {
+			return b.holiday, true
+		}
+	}
+	return Holiday{}, false
+}
+
+// holidayKeys 为日历中的节日分配 JSON、iCalendar 数据中的标识：内置节日使用 builtinHolidays 中的标识，
+// 自定义节日由英文名转换而来，英文名中没有字母、数字时为 holiday，与已分配的标识重复时加上数字后缀，
+// 保证不同的节日标识不同、且不为空
+type holidayKeys struct {
+	keys map[Holiday]string
+	used map[string]bool
+}
+
+func newHolidayKeys() *holidayKeys {
+	k := &holidayKeys{keys: make(map[Holiday]string), used: make(map[string]bool)}
+	for _, b := range builtinHolidays {
+		k.keys[b.holiday] = b.key
+		k.used[b.key] = true
+	}
+	return k
+}
+
+// key 返回 h 的标识，isNew 表示 h 为第一次出现的自定义节日，需要写出定义
+func (k *holidayKeys) key(h Holiday) (key string, isNew bool) {
+	if key, ok := k.keys[h]; ok {
+		return key, false
+	}
+	base := strings.Join(strings.FieldsFunc(strings.ToLower(h.engName), func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < '0' || r > '9')
+	}), "_")
+	if base == "" {
+		base = "holiday"
+	}
+	key = base
+	for i := 2; k.used[key]; i++ {
+		key = fmt.Sprintf("%s_%d", base, i)
+	}
+	k.keys[h] = key
+	k.used[key] = true
+	return key, true
+}
+
+// jsonData JSON 数据格式，见 WriteJSON
+type jsonData struct {
+	Version   int           `json:"version"`
+	Start     string        `json:"start"`
+	End       string        `json:"end"`
+	Projected bool          `json:"projected,omitempty"`
+	Holidays  []jsonHoliday `json:"holidays,omitempty"`
+	Days      []jsonDay     `json:"days"`
+}
+
+type jsonHoliday struct {
+	Key     string `json:"key"`
+	Name    string `json:"name"`
+	EngName string `json:"engName"`
+	Days    int    `json:"days"`
+	Scope   string `json:"scope,omitempty"`
+}
+
+type jsonDay struct {
+	Date      string `json:"date"`
+	Year      int    `json:"year,omitempty"`
+	Type      string `json:"type"`
+	Holiday   string `json:"holiday"`
+	Name      string `json:"name,omitempty"`
+	EngName   string `json:"engName,omitempty"`
+	Statutory bool   `json:"statutory,omitempty"`
+	InLieu    bool   `json:"inLieu,omitempty"`
+	HalfDay   bool   `json:"halfDay,omitempty"`
+}
+
+const (
+	jsonTypeHoliday = "holiday"
+	jsonTypeWorkday = "workday"
+)
+
+func parseDate(s string) (time.Time, error) {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid date %q", ErrInvalidArrangement, s)
+	}
+	return t, nil
+}
+
+// LoadJSON 从 JSON 数据构建日历，数据格式见 WriteJSON
+func LoadJSON(r io.Reader) (*Calendar, error) {
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	var data jsonData
+	if err := decoder.Decode(&data); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArrangement, err)
+	}
+	if data.Version != DataVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, data.Version)
+	}
+
+	start, err := parseDate(data.Start)
+	if err != nil {
+		return nil, err
+	}
+	end, err := parseDate(data.End)
+	if err != nil {
+		return nil, err
+	}
+
+	holidays := make(map[string]Holiday, len(builtinHolidays)+len(data.Holidays))
+	for _, b := range builtinHolidays {
+		holidays[b.key] = b.holiday
+	}
+	for _, h := range data.Holidays {
+		if _, ok := holidays[h.Key]; ok || h.Key == "" {
+			return nil, fmt.Errorf("%w: holiday key %q is empty or duplicated", ErrInvalidArrangement, h.Key)
+		}
+		scope, ok := parseHolidayScope(h.Scope)
+		if !ok {
+			return nil, fmt.Errorf("%w: holiday %q has unknown scope %q", ErrInvalidArrangement, h.Key, h.Scope)
+		}
+		holidays[h.Key] = Holiday{h.EngName, h.Name, h.Days, scope}
+	}
+
+	arrangements := make([]Arrangement, 0, len(data.Days))
+	for _, day := range data.Days {
+		date, err := parseDate(day.Date)
+		if err != nil {
+			return nil, err
+		}
+		holiday, ok := holidays[day.Holiday]
+		switch {
+		case !ok:
+			return nil, fmt.Errorf("%w: %s has unknown holiday %q", ErrInvalidArrangement, day.Date, day.Holiday)
+		case day.Year != 0 && day.Year != date.Year():
+			return nil, fmt.Errorf("%w: %s has year %d", ErrInvalidArrangement, day.Date, day.Year)
+		case day.Name != "" && day.Name != holiday.name, day.EngName != "" && day.EngName != holiday.engName:
+			return nil, fmt.Errorf("%w: %s has name %q/%q, but %q is %q/%q", ErrInvalidArrangement,
+				day.Date, day.Name, day.EngName, day.Holiday, holiday.name, holiday.engName)
+		case day.Type != jsonTypeHoliday && day.Type != jsonTypeWorkday:
+			return nil, fmt.Errorf("%w: %s has unknown type %q", ErrInvalidArrangement, day.Date, day.Type)
+		}
+		arrangements = append(arrangements, Arrangement{
+			Date:      date,
+			Holiday:   holiday,
+			Workday:   day.Type == jsonTypeWorkday,
+			Statutory: day.Statutory,
+			InLieu:    day.InLieu,
+			HalfDay:   day.HalfDay,
+		})
+	}
+	c, err := NewCalendar(start, end, arrangements)
+	if err != nil {
+		return nil, err
+	}
+	c.projected = data.Projected
+	return c, nil
+}
+
+// LoadJSONFile 从 JSON 文件构建日历，数据格式见 WriteJSON
+func LoadJSONFile(path string) (*Calendar, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return LoadJSON(file)
+}
+
+// WriteJSON 将日历写为 JSON 数据，每个有放假安排的日期一条记录：
+//
+//	{
+//	  "version": 1,
+//	  "start": "2004-01-01",
+//	  "end": "2024-10-12",
+//	  "holidays": [{"key": "anniversary", "name": "司庆", "engName": "Anniversary", "days": 0}],
+//	  "days": [
+//	    {"date": "2024-10-01", "year": 2024, "type": "holiday", "holiday": "national_day", "name": "国庆节", "engName": "National Day", "statutory": true},
+//	    {"date": "2024-10-12", "year": 2024, "type": "workday", "holiday": "national_day", "name": "国庆节", "engName": "National Day"}
+//	  ]
+//	}
+//
+// start、end 为数据覆盖的日期范围；推算的日历（见 ProjectCalendar）另有 "projected": true，正式安排省略；holidays 定义内置节日以外的节日，没有时省略，
+// 自定义节日的 key 由英文名生成，与内置或其他节日重复、或英文名中没有字母数字时加上数字后缀区分；
+// 地方节日的 scope 为 provincial（见 HolidayScope），全国性的节日省略 scope；
+// type 为 holiday（放假）或 workday（调休上班），holiday 为内置或 holidays 中定义的节日标识；
+// 只放假半天的日期（见 Arrangement.HalfDay）type 为 workday，另有 "halfDay": true。
+// LoadJSON 读取时 year、name、engName 可省略，给出时必须与 date、holiday 一致
+func (c *Calendar) WriteJSON(w io.Writer) error {
+	data := jsonData{
+		Version:   DataVersion,
+		Start:     c.minDay.String(),
+		End:       c.maxDay.String(),
+		Projected: c.projected,
+		Days:      make([]jsonDay, 0),
+	}
+
+	keys := newHolidayKeys()
+	for _, a := range c.Arrangements() {
+		key, isNew := keys.key(a.Holiday)
+		if isNew {
+			holiday := jsonHoliday{key, a.Holiday.name, a.Holiday.engName, a.Holiday.days, ""}
+			if a.Holiday.scope != NationalScope {
+				holiday.Scope = a.Holiday.scope.String()
+			}
+			data.Holidays = append(data.Holidays, holiday)
+		}
+		day := jsonDay{
+			Date:      civilDateOf(a.Date).String(),
+			Year:      a.Date.Year(),
+			Type:      jsonTypeHoliday,
+			Holiday:   key,
+			Name:      a.Holiday.name,
+			EngName:   a.Holiday.engName,
+			Statutory: a.Statutory,
+			InLieu:    a.InLieu,
+			HalfDay:   a.HalfDay,
+		}
+		if a.Workday {
+			day.Type = jsonTypeWorkday
+		}
+		data.Days = append(data.Days, day)
+	}
+
+	// 每条记录占一行，便于查看差异
+	buffer := &bytes.Buffer{}
+	fmt.Fprintf(buffer, "{\n  \"version\": %d,\n  \"start\": %q,\n  \"end\": %q,\n", data.Version, data.Start, data.End)
+	if data.Projected {
+		buffer.WriteString("  \"projected\": true,\n")
+	}
+	if len(data.Holidays) > 0 {
+		if err := writeJSONList(buffer, "holidays", len(data.Holidays), func(i int) interface{} { return data.Holidays[i] }); err != nil {
+			return err
+		}
+		buffer.WriteString(",\n")
+	}
+	if err := writeJSONList(buffer, "days", len(data.Days), func(i int) interface{} { return data.Days[i] }); err != nil {
+		return err
+	}
+	buffer.WriteString("\n}\n")
+
+	_, err := w.Write(buffer.Bytes())
+	return err
+}
+
+// Version 返回数据的版本标识，由 WriteJSON 的内容计算得到：
+// 放假安排、日期范围与是否推算都相同的日历版本相同，数据有任何变化时版本随之改变，可用作缓存的 ETag
+func (c *Calendar) Version() string {
+	c.versionOnce.Do(func() {
+		hash := sha256.New()
+		if err := c.WriteJSON(hash); err != nil {
+			panic(err)
+		}
+		c.version = hex.EncodeToString(hash.Sum(nil))[:16]
+	})
+	return c.version
+}
+
+func writeJSONList(buffer *bytes.Buffer, name string, n int, item func(i int) interface{}) error {
+	fmt.Fprintf(buffer, "  %q: [", name)
+	for i := 0; i < n; i++ {
+		b, err := json.Marshal(item(i))
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			buffer.WriteString(",")
+		}
+		buffer.WriteString("\n    ")
+		buffer.Write(b)
+	}
+	if n > 0 {
+		buffer.WriteString("\n  ")
+	}
+	buffer.WriteString("]")
+	return nil
+}
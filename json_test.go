@@ -0,0 +1,138 @@
+package chinesecalendar
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, Default().WriteJSON(buffer))
+
+	c, err := LoadJSON(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultCalendar.tables, c.tables)
+	assert.Equal(t, defaultCalendar.minDay, c.minDay)
+	assert.Equal(t, defaultCalendar.maxDay, c.maxDay)
+}
+
+func TestLoadJSONFile(t *testing.T) {
+	c, err := LoadJSONFile("testdata/2030.json")
+	assert.NoError(t, err)
+
+	assert.Equal(t, true, c.IsHoliday(time.Date(2030, 2, 4, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.Equal(t, true, c.IsWorkday(time.Date(2030, 2, 9, 0, 0, 0, 0, ChinaStandardTime)))
+	holiday, isHoliday := c.GetHolidayDetail(time.Date(2030, 6, 6, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, true, isHoliday)
+	assert.Equal(t, "司庆", holiday.Name())
+
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, c.WriteJSON(buffer))
+	assert.Contains(t, buffer.String(), `"holidays": [`+"\n"+`    {"key":"anniversary","name":"司庆","engName":"Anniversary","days":0}`)
+	reloaded, err := LoadJSON(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, c.tables, reloaded.tables)
+}
+
+func TestJSONRoundTripCustomKeys(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2030, 5, d, 0, 0, 0, 0, ChinaStandardTime) }
+	// 与内置节日英文名相同、英文名为空或不含字母数字、英文名只在大小写与标点上不同的自定义节日
+	springFestival := NewHoliday("Spring Festival", "春节", 3)
+	unnamed := NewHoliday("", "厂庆", 1)
+	chinese := NewHoliday("司庆日", "司庆", 0)
+	anniversary := NewHoliday("Anniversary", "周年庆", 0)
+	anniversary2 := NewHoliday("anniversary!", "十周年庆", 0)
+	holidays := []Holiday{springFestival, SpringFestival, unnamed, chinese, anniversary, anniversary2}
+	arrangements := make([]Arrangement, 0, len(holidays))
+	for i, h := range holidays {
+		arrangements = append(arrangements, Arrangement{Date: day(i + 6), Holiday: h})
+	}
+	c, err := NewCalendar(day(1), day(31), arrangements)
+	assert.NoError(t, err)
+
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, c.WriteJSON(buffer))
+	for _, key := range []string{`"spring_festival_2"`, `"holiday"`, `"holiday_2"`, `"anniversary"`, `"anniversary_2"`} {
+		assert.Contains(t, buffer.String(), `{"key":`+key)
+	}
+	loaded, err := LoadJSON(bytes.NewReader(buffer.Bytes()))
+	assert.NoError(t, err)
+	for i, h := range holidays {
+		got, ok := loaded.GetHolidayDetail(day(i + 6))
+		assert.Equal(t, true, ok)
+		assert.Equal(t, h, got, h.Name())
+	}
+	assert.Equal(t, 3, springFestival.Days())
+	assert.Equal(t, c.Version(), loaded.Version())
+
+	// 只有自定义节日不同的日历版本不同
+	arrangements[0].Holiday = SpringFestival
+	other, err := NewCalendar(day(1), day(31), arrangements)
+	assert.NoError(t, err)
+	assert.NotEqual(t, c.Version(), other.Version())
+}
+
+func TestLoadJSONInvalid(t *testing.T) {
+	invalid := []string{
+		`{"version": 1, "start": "2030-01-01", "end": "2030-12-31", "days": [{"date": "2030-13-01", "type": "holiday", "holiday": "new_years_day"}]}`,
+		`{"version": 1, "start": "2030-01-01", "end": "2030-12-31", "days": [{"date": "2030-01-01", "type": "rest", "holiday": "new_years_day"}]}`,
+		`{"version": 1, "start": "2030-01-01", "end": "2030-12-31", "days": [{"date": "2030-01-01", "type": "holiday", "holiday": "christmas"}]}`,
+		`{"version": 1, "start": "2030-01-01", "end": "2030-12-31", "days": [{"date": "2030-01-01", "year": 2031, "type": "holiday", "holiday": "new_years_day"}]}`,
+		`{"version": 1, "start": "2030-01-01", "end": "2030-12-31", "days": [{"date": "2030-01-01", "type": "holiday", "holiday": "new_years_day", "name": "春节"}]}`,
+		`{"version": 1, "start": "2030-01-01", "end": "2030-12-31", "days": [{"date": "2031-01-01", "type": "holiday", "holiday": "new_years_day"}]}`,
+		`{"version": 1, "start": "2030-01-01", "end": "2030-12-31", "days": [], "extra": true}`,
+		`{"version": 1, "start": "2030-01-01", "end": "2030-12-31", "holidays": [{"key": "national_day", "name": "国庆", "engName": "National Day"}], "days": []}`,
+		`{"version": 1, "start": "2030-01-01", "end": "2030-12-31", "holidays": [{"key": "losar", "name": "藏历新年", "engName": "Losar", "scope": "county"}], "days": []}`,
+		`{"version": 1, "start": "2030-01-01", "end": "2030-12-31", "days": [`,
+	}
+	for _, data := range invalid {
+		_, err := LoadJSON(strings.NewReader(data))
+		assert.True(t, errors.Is(err, ErrInvalidArrangement), data)
+	}
+
+	_, err := LoadJSON(strings.NewReader(`{"version": 2, "start": "2030-01-01", "end": "2030-12-31", "days": []}`))
+	assert.True(t, errors.Is(err, ErrUnsupportedVersion))
+}
+
+func TestVersion(t *testing.T) {
+	assert.Equal(t, 16, len(Default().Version()))
+
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, Default().WriteJSON(buffer))
+	c, err := LoadJSON(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, Default().Version(), c.Version())
+
+	other, err := LoadJSONFile("testdata/2030.json")
+	assert.NoError(t, err)
+	assert.NotEqual(t, Default().Version(), other.Version())
+}
+
+func TestJSONProjected(t *testing.T) {
+	projected, err := ProjectCalendar(2026, 2027)
+	assert.NoError(t, err)
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, projected.WriteJSON(buffer))
+	assert.Contains(t, buffer.String(), "\n  \"projected\": true,\n")
+
+	c, err := LoadJSON(bytes.NewReader(buffer.Bytes()))
+	assert.NoError(t, err)
+	assert.True(t, c.Projected())
+	assert.Equal(t, projected.Version(), c.Version())
+
+	// 日期与放假安排相同的正式安排版本不同
+	start, end := projected.SupportedRange()
+	confirmed, err := NewCalendar(start, end, projected.Arrangements())
+	assert.NoError(t, err)
+	assert.False(t, confirmed.Projected())
+	assert.NotEqual(t, projected.Version(), confirmed.Version())
+
+	buffer.Reset()
+	assert.NoError(t, Default().WriteJSON(buffer))
+	assert.NotContains(t, buffer.String(), "projected")
+}
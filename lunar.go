@@ -0,0 +1,197 @@
+package chinesecalendar
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// LunarDate 农历日期
+type LunarDate struct {
+	// Year 农历年，与该年正月初一所在的公历年相同
+	Year int
+	// Month 月份，1 至 12，闰月与其前一个月的月份相同
+	Month int
+	// Day 日，1 至 30
+	Day int
+	// IsLeapMonth 是否闰月
+	IsLeapMonth bool
+}
+
+// lunarYear 一个农历年的数据
+type lunarYear struct {
+	// newYear 正月初一距 lunarEpoch 的天数
+	newYear int32
+	// leapMonth 闰几月，没有闰月时为 0
+	leapMonth uint8
+	// bigMonths 按先后顺序（闰月排在同名月之后）第 i 个月为大月（30 天）时第 i 位为 1，小月为 29 天
+	bigMonths uint16
+}
+
+// lunarEpoch 农历 1900 年正月初一，lunarYears 的起点
+var lunarEpoch = civilDate{1900, time.January, 31}
+
+// months 返回该年按先后顺序的月数
+func (y *lunarYear) months() int {
+	if y.leapMonth != 0 {
+		return 13
+	}
+	return 12
+}
+
+// monthDays 返回按先后顺序第 i 个月的天数
+func (y *lunarYear) monthDays(i int) int {
+	if y.bigMonths&(1<<uint(i)) != 0 {
+		return 30
+	}
+	return 29
+}
+
+// days 返回该年的天数
+func (y *lunarYear) days() int {
+	n := 0
+	for i := 0; i < y.months(); i++ {
+		n += y.monthDays(i)
+	}
+	return n
+}
+
+// monthAt 返回按先后顺序第 i 个月的月份及是否闰月
+func (y *lunarYear) monthAt(i int) (int, bool) {
+	leap := int(y.leapMonth)
+	switch {
+	case leap == 0 || i < leap:
+		return i + 1, false
+	case i == leap:
+		return leap, true
+	default:
+		return i, false
+	}
+}
+
+// indexOf 是 monthAt 的逆运算，月份不存在时返回 -1
+func (y *lunarYear) indexOf(month int, isLeapMonth bool) int {
+	leap := int(y.leapMonth)
+	switch {
+	case month < 1 || month > 12:
+		return -1
+	case isLeapMonth && month != leap:
+		return -1
+	case isLeapMonth || (leap != 0 && month > leap):
+		return month
+	default:
+		return month - 1
+	}
+}
+
+// lunarYearOf 返回农历 year 年的数据，不在支持范围内时返回 nil
+func lunarYearOf(year int) *lunarYear {
+	n := year - lunarEpoch.year
+	if n < 0 || n >= len(lunarYears) {
+		return nil
+	}
+	return &lunarYears[n]
+}
+
+// LunarDateOf 将 t 在中国的公历日期转换为农历日期，
+// 支持农历 1900 年正月初一（1900-01-31）至农历 2100 年除夕，超出范围时返回 ErrUnSupportLunarDate
+func LunarDateOf(t time.Time) (LunarDate, error) {
+	offset := lunarEpoch.daysUntil(civilDateOf(t))
+	n := sort.Search(len(lunarYears), func(i int) bool { return int(lunarYears[i].newYear) > offset }) - 1
+	if n < 0 {
+		return LunarDate{}, ErrUnSupportLunarDate
+	}
+
+	y := &lunarYears[n]
+	offset -= int(y.newYear)
+	for i := 0; i < y.months(); i++ {
+		if days := y.monthDays(i); offset >= days {
+			offset -= days
+			continue
+		}
+		month, isLeapMonth := y.monthAt(i)
+		return LunarDate{lunarEpoch.year + n, month, offset + 1, isLeapMonth}, nil
+	}
+	return LunarDate{}, ErrUnSupportLunarDate
+}
+
+// Time 返回农历日期对应的公历日期，为 loc 时区的零点。
+// 年份超出支持范围时返回 ErrUnSupportLunarDate，日期不存在（如该年没有这个闰月、小月的三十）时返回 ErrInvalidLunarDate
+func (d LunarDate) Time(loc *time.Location) (time.Time, error) {
+	y := lunarYearOf(d.Year)
+	if y == nil {
+		return time.Time{}, ErrUnSupportLunarDate
+	}
+	index := y.indexOf(d.Month, d.IsLeapMonth)
+	if index < 0 || d.Day < 1 || d.Day > y.monthDays(index) {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrInvalidLunarDate, d)
+	}
+
+	offset := int(y.newYear) + d.Day - 1
+	for i := 0; i < index; i++ {
+		offset += y.monthDays(i)
+	}
+	return lunarEpoch.addDays(offset).time(loc), nil
+}
+
+// LunarLeapMonth 返回农历 year 年闰几月，没有闰月或超出支持范围时返回 0
+func LunarLeapMonth(year int) int {
+	if y := lunarYearOf(year); y != nil {
+		return int(y.leapMonth)
+	}
+	return 0
+}
+
+var (
+	heavenlyStems   = []rune("甲乙丙丁戊己庚辛壬癸")
+	earthlyBranches = []rune("子丑寅卯辰巳午未申酉戌亥")
+	zodiacs         = []rune("鼠牛虎兔龙蛇马羊猴鸡狗猪")
+	lunarMonthNames = []string{"正", "二", "三", "四", "五", "六", "七", "八", "九", "十", "冬", "腊"}
+	lunarDigits     = []string{"", "一", "二", "三", "四", "五", "六", "七", "八", "九", "十"}
+)
+
+// GanZhi 返回年份的干支，例如 2024 年为“甲辰”
+func (d LunarDate) GanZhi() string {
+	n := ((d.Year-4)%60 + 60) % 60
+	return string(heavenlyStems[n%10]) + string(earthlyBranches[n%12])
+}
+
+// Zodiac 返回年份的生肖，例如 2024 年为“龙”
+func (d LunarDate) Zodiac() string {
+	return string(zodiacs[((d.Year-4)%12+12)%12])
+}
+
+// MonthName 返回月份的名称，例如“正月”“闰四月”“腊月”
+func (d LunarDate) MonthName() string {
+	if d.Month < 1 || d.Month > 12 {
+		return ""
+	}
+	name := lunarMonthNames[d.Month-1] + "月"
+	if d.IsLeapMonth {
+		name = "闰" + name
+	}
+	return name
+}
+
+// DayName 返回日的名称，例如“初一”“十五”“廿三”
+func (d LunarDate) DayName() string {
+	switch {
+	case d.Day < 1 || d.Day > 30:
+		return ""
+	case d.Day <= 10:
+		return "初" + lunarDigits[d.Day]
+	case d.Day < 20:
+		return "十" + lunarDigits[d.Day-10]
+	case d.Day == 20:
+		return "二十"
+	case d.Day < 30:
+		return "廿" + lunarDigits[d.Day-20]
+	default:
+		return "三十"
+	}
+}
+
+// String 返回农历日期的中文表示，例如“甲辰年八月十五”
+func (d LunarDate) String() string {
+	return d.GanZhi() + "年" + d.MonthName() + d.DayName()
+}
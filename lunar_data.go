@@ -0,0 +1,208 @@
+// Code generated by "scripts/generator"; DO NOT EDIT.
+
+package chinesecalendar
+
+// lunarYears 农历 1900 年至 2100 年每年的数据，见 lunarYear
+var lunarYears = [...]lunarYear{
+	{0, 8, 0x16d2},      // 1900
+	{384, 0, 0x0752},    // 1901
+	{738, 0, 0x0ea5},    // 1902
+	{1093, 5, 0x164a},   // 1903
+	{1476, 0, 0x064b},   // 1904
+	{1830, 0, 0x0a9b},   // 1905
+	{2185, 4, 0x1556},   // 1906
+	{2569, 0, 0x056a},   // 1907
+	{2923, 0, 0x0b59},   // 1908
+	{3278, 2, 0x1752},   // 1909
+	{3662, 0, 0x0752},   // 1910
+	{4016, 6, 0x1b25},   // 1911
+	{4400, 0, 0x0b25},   // 1912
+	{4754, 0, 0x0a4b},   // 1913
+	{5108, 5, 0x12ab},   // 1914
+	{5492, 0, 0x0aad},   // 1915
+	{5847, 0, 0x056a},   // 1916
+	{6201, 2, 0x0b69},   // 1917
+	{6585, 0, 0x0da9},   // 1918
+	{6940, 7, 0x1d92},   // 1919
+	{7324, 0, 0x0d92},   // 1920
+	{7678, 0, 0x0d25},   // 1921
+	{8032, 5, 0x1a4d},   // 1922
+	{8416, 0, 0x0a56},   // 1923
+	{8770, 0, 0x02b6},   // 1924
+	{9124, 4, 0x15b5},   // 1925
+	{9509, 0, 0x06d4},   // 1926
+	{9863, 0, 0x0ea9},   // 1927
+	{10218, 2, 0x1e92},  // 1928
+	{10602, 0, 0x0e92},  // 1929
+	{10956, 6, 0x0d26},  // 1930
+	{11339, 0, 0x052b},  // 1931
+	{11693, 0, 0x0a57},  // 1932
+	{12048, 5, 0x12b6},  // 1933
+	{12432, 0, 0x0b5a},  // 1934
+	{12787, 0, 0x06d4},  // 1935
+	{13141, 3, 0x0ec9},  // 1936
+	{13525, 0, 0x0749},  // 1937
+	{13879, 7, 0x1693},  // 1938
+	{14263, 0, 0x0a93},  // 1939
+	{14617, 0, 0x052b},  // 1940
+	{14971, 6, 0x0a5b},  // 1941
+	{15355, 0, 0x0aad},  // 1942
+	{15710, 0, 0x056a},  // 1943
+	{16064, 4, 0x1b55},  // 1944
+	{16449, 0, 0x0ba4},  // 1945
+	{16803, 0, 0x0b49},  // 1946
+	{17157, 2, 0x1a93},  // 1947
+	{17541, 0, 0x0a95},  // 1948
+	{17895, 7, 0x152d},  // 1949
+	{18279, 0, 0x0536},  // 1950
+	{18633, 0, 0x0aad},  // 1951
+	{18988, 5, 0x15aa},  // 1952
+	{19372, 0, 0x05b2},  // 1953
+	{19726, 0, 0x0da5},  // 1954
+	{20081, 3, 0x1d4a},  // 1955
+	{20465, 0, 0x0d4a},  // 1956
+	{20819, 8, 0x0a95},  // 1957
+	{21202, 0, 0x0a97},  // 1958
+	{21557, 0, 0x0556},  // 1959
+	{21911, 6, 0x0ab5},  // 1960
+	{22295, 0, 0x0ad5},  // 1961
+	{22650, 0, 0x06d2},  // 1962
+	{23004, 4, 0x0ea5},  // 1963
+	{23388, 0, 0x0ea5},  // 1964
+	{23743, 0, 0x064a},  // 1965
+	{24096, 3, 0x0c97},  // 1966
+	{24480, 0, 0x0a9b},  // 1967
+	{24835, 7, 0x155a},  // 1968
+	{25219, 0, 0x056a},  // 1969
+	{25573, 0, 0x0b69},  // 1970
+	{25928, 5, 0x1752},  // 1971
+	{26312, 0, 0x0b52},  // 1972
+	{26666, 0, 0x0b25},  // 1973
+	{27020, 4, 0x164b},  // 1974
+	{27404, 0, 0x0a4b},  // 1975
+	{27758, 8, 0x14ab},  // 1976
+	{28142, 0, 0x02ad},  // 1977
+	{28496, 0, 0x056d},  // 1978
+	{28851, 6, 0x0b69},  // 1979
+	{29235, 0, 0x0da9},  // 1980
+	{29590, 0, 0x0d92},  // 1981
+	{29944, 4, 0x1d25},  // 1982
+	{30328, 0, 0x0d25},  // 1983
+	{30682, 10, 0x1a4d}, // 1984
+	{31066, 0, 0x0a56},  // 1985
+	{31420, 0, 0x02b6},  // 1986
+	{31774, 6, 0x05b5},  // 1987
+	{32158, 0, 0x06d5},  // 1988
+	{32513, 0, 0x0ea9},  // 1989
+	{32868, 5, 0x1e92},  // 1990
+	{33252, 0, 0x0e92},  // 1991
+	{33606, 0, 0x0d26},  // 1992
+	{33960, 3, 0x0a56},  // 1993
+	{34343, 0, 0x0a57},  // 1994
+	{34698, 8, 0x14d6},  // 1995
+	{35082, 0, 0x035a},  // 1996
+	{35436, 0, 0x06d5},  // 1997
+	{35791, 5, 0x16c9},  // 1998
+	{36175, 0, 0x0749},  // 1999
+	{36529, 0, 0x0693},  // 2000
+	{36883, 4, 0x152b},  // 2001
+	{37267, 0, 0x052b},  // 2002
+	{37621, 0, 0x0a5b},  // 2003
+	{37976, 2, 0x155a},  // 2004
+	{38360, 0, 0x056a},  // 2005
+	{38714, 7, 0x1b55},  // 2006
+	{39099, 0, 0x0ba4},  // 2007
+	{39453, 0, 0x0b49},  // 2008
+	{39807, 5, 0x1a93},  // 2009
+	{40191, 0, 0x0a95},  // 2010
+	{40545, 0, 0x052d},  // 2011
+	{40899, 4, 0x0aad},  // 2012
+	{41283, 0, 0x0ab5},  // 2013
+	{41638, 9, 0x15aa},  // 2014
+	{42022, 0, 0x05d2},  // 2015
+	{42376, 0, 0x0da5},  // 2016
+	{42731, 6, 0x1d4a},  // 2017
+	{43115, 0, 0x0d4a},  // 2018
+	{43469, 0, 0x0c95},  // 2019
+	{43823, 4, 0x152e},  // 2020
+	{44207, 0, 0x0556},  // 2021
+	{44561, 0, 0x0ab5},  // 2022
+	{44916, 2, 0x15b2},  // 2023
+	{45300, 0, 0x06d2},  // 2024
+	{45654, 6, 0x0ea5},  // 2025
+	{46038, 0, 0x0725},  // 2026
+	{46392, 0, 0x064b},  // 2027
+	{46746, 5, 0x0c97},  // 2028
+	{47130, 0, 0x0cab},  // 2029
+	{47485, 0, 0x055a},  // 2030
+	{47839, 3, 0x0ad6},  // 2031
+	{48223, 0, 0x0b69},  // 2032
+	{48578, 11, 0x1752}, // 2033
+	{48962, 0, 0x0b52},  // 2034
+	{49316, 0, 0x0b25},  // 2035
+	{49670, 6, 0x1a4b},  // 2036
+	{50054, 0, 0x0a4b},  // 2037
+	{50408, 0, 0x04ab},  // 2038
+	{50762, 5, 0x055b},  // 2039
+	{51146, 0, 0x05ad},  // 2040
+	{51501, 0, 0x0b6a},  // 2041
+	{51856, 2, 0x1b52},  // 2042
+	{52240, 0, 0x0d92},  // 2043
+	{52594, 7, 0x1d25},  // 2044
+	{52978, 0, 0x0d25},  // 2045
+	{53332, 0, 0x0a55},  // 2046
+	{53686, 5, 0x14ad},  // 2047
+	{54070, 0, 0x04b6},  // 2048
+	{54424, 0, 0x05b5},  // 2049
+	{54779, 3, 0x0daa},  // 2050
+	{55163, 0, 0x0ec9},  // 2051
+	{55518, 8, 0x1e92},  // 2052
+	{55902, 0, 0x0e92},  // 2053
+	{56256, 0, 0x0d26},  // 2054
+	{56610, 6, 0x0a56},  // 2055
+	{56993, 0, 0x0a57},  // 2056
+	{57348, 0, 0x0556},  // 2057
+	{57702, 4, 0x06d5},  // 2058
+	{58086, 0, 0x0755},  // 2059
+	{58441, 0, 0x0749},  // 2060
+	{58795, 3, 0x0e93},  // 2061
+	{59179, 0, 0x0693},  // 2062
+	{59533, 7, 0x152b},  // 2063
+	{59917, 0, 0x052b},  // 2064
+	{60271, 0, 0x0a5b},  // 2065
+	{60626, 5, 0x155a},  // 2066
+	{61010, 0, 0x056a},  // 2067
+	{61364, 0, 0x0b65},  // 2068
+	{61719, 4, 0x174a},  // 2069
+	{62103, 0, 0x0b4a},  // 2070
+	{62457, 8, 0x1a95},  // 2071
+	{62841, 0, 0x0a95},  // 2072
+	{63195, 0, 0x052d},  // 2073
+	{63549, 6, 0x0aad},  // 2074
+	{63933, 0, 0x0ab5},  // 2075
+	{64288, 0, 0x05aa},  // 2076
+	{64642, 4, 0x0ba5},  // 2077
+	{65026, 0, 0x0da5},  // 2078
+	{65381, 0, 0x0d4a},  // 2079
+	{65735, 3, 0x1c95},  // 2080
+	{66119, 0, 0x0c96},  // 2081
+	{66473, 7, 0x194e},  // 2082
+	{66857, 0, 0x0556},  // 2083
+	{67211, 0, 0x0ab5},  // 2084
+	{67566, 5, 0x15b2},  // 2085
+	{67950, 0, 0x06d2},  // 2086
+	{68304, 0, 0x0ea5},  // 2087
+	{68659, 4, 0x0e4a},  // 2088
+	{69042, 0, 0x068b},  // 2089
+	{69396, 8, 0x0c97},  // 2090
+	{69780, 0, 0x04ab},  // 2091
+	{70134, 0, 0x055b},  // 2092
+	{70489, 6, 0x0ad6},  // 2093
+	{70873, 0, 0x0b6a},  // 2094
+	{71228, 0, 0x0752},  // 2095
+	{71582, 4, 0x1725},  // 2096
+	{71966, 0, 0x0b45},  // 2097
+	{72320, 0, 0x0a8b},  // 2098
+	{72674, 2, 0x149b},  // 2099
+	{73058, 0, 0x04ab},  // 2100
+}
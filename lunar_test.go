@@ -0,0 +1,144 @@
+package chinesecalendar
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, ChinaStandardTime)
+}
+
+func TestLunarNewYear(t *testing.T) {
+	springFestivals := []time.Time{
+		date(1900, 1, 31), date(1949, 1, 29), date(1976, 1, 31), date(1984, 2, 2),
+		date(2000, 2, 5), date(2001, 1, 24), date(2002, 2, 12), date(2003, 2, 1), date(2004, 1, 22),
+		date(2005, 2, 9), date(2006, 1, 29), date(2007, 2, 18), date(2008, 2, 7), date(2009, 1, 26),
+		date(2010, 2, 14), date(2011, 2, 3), date(2012, 1, 23), date(2013, 2, 10), date(2014, 1, 31),
+		date(2015, 2, 19), date(2016, 2, 8), date(2017, 1, 28), date(2018, 2, 16), date(2019, 2, 5),
+		date(2020, 1, 25), date(2021, 2, 12), date(2022, 2, 1), date(2023, 1, 22), date(2024, 2, 10),
+		date(2025, 1, 29), date(2026, 2, 17), date(2027, 2, 6), date(2028, 1, 26), date(2029, 2, 13),
+		date(2030, 2, 3), date(2050, 1, 23), date(2100, 2, 9),
+	}
+	for _, sf := range springFestivals {
+		d, err := LunarDateOf(sf)
+		assert.NoError(t, err)
+		assert.Equal(t, LunarDate{sf.Year(), 1, 1, false}, d, sf)
+
+		eve, err := LunarDateOf(sf.AddDate(0, 0, -1))
+		if sf.Year() > 1900 {
+			assert.NoError(t, err)
+			assert.Equal(t, 12, eve.Month, sf)
+			assert.Equal(t, sf.Year()-1, eve.Year, sf)
+		}
+	}
+}
+
+func TestLunarFestivals(t *testing.T) {
+	// 端午（五月初五）、中秋（八月十五），与放假安排中的法定假日一致
+	festivals := []struct {
+		dragonBoat, midAutumn time.Time
+	}{
+		{date(2008, 6, 8), date(2008, 9, 14)},
+		{date(2009, 5, 28), date(2009, 10, 3)},
+		{date(2012, 6, 23), date(2012, 9, 30)},
+		{date(2017, 5, 30), date(2017, 10, 4)},
+		{date(2020, 6, 25), date(2020, 10, 1)},
+		{date(2023, 6, 22), date(2023, 9, 29)},
+		{date(2024, 6, 10), date(2024, 9, 17)},
+	}
+	for _, f := range festivals {
+		d, err := LunarDateOf(f.dragonBoat)
+		assert.NoError(t, err)
+		assert.Equal(t, LunarDate{f.dragonBoat.Year(), 5, 5, false}, d)
+		d, err = LunarDateOf(f.midAutumn)
+		assert.NoError(t, err)
+		assert.Equal(t, LunarDate{f.midAutumn.Year(), 8, 15, false}, d)
+	}
+}
+
+func TestLunarLeapMonth(t *testing.T) {
+	leapMonths := map[int]int{
+		1900: 8, 2001: 4, 2004: 2, 2006: 7, 2009: 5, 2012: 4, 2014: 9, 2017: 6,
+		2020: 4, 2023: 2, 2025: 6, 2028: 5, 2031: 3, 2033: 11,
+	}
+	for year := 2000; year <= 2035; year++ {
+		assert.Equal(t, leapMonths[year], LunarLeapMonth(year), year)
+	}
+	assert.Equal(t, 8, LunarLeapMonth(1900))
+	assert.Equal(t, 0, LunarLeapMonth(1899))
+	assert.Equal(t, 0, LunarLeapMonth(2101))
+
+	// 2023 年闰二月初一
+	d, err := LunarDateOf(date(2023, 3, 22))
+	assert.NoError(t, err)
+	assert.Equal(t, LunarDate{2023, 2, 1, true}, d)
+	assert.Equal(t, "癸卯年闰二月初一", d.String())
+	// 2033 年闰十一月
+	d, err = LunarDateOf(date(2033, 12, 22))
+	assert.NoError(t, err)
+	assert.Equal(t, LunarDate{2033, 11, 1, true}, d)
+}
+
+// TestLunarRoundTrip 逐日遍历公历日期（不经过任何时区），农历日期换算回公历后不变
+func TestLunarRoundTrip(t *testing.T) {
+	first, last := civilDate{1900, time.January, 31}, civilDate{2101, time.January, 28}
+	prev := LunarDate{}
+	for day := first; !day.after(last); day = day.addDays(1) {
+		d, err := LunarDateOf(day.time(time.UTC))
+		if !assert.NoError(t, err, day) {
+			return
+		}
+		back, err := d.Time(time.UTC)
+		assert.NoError(t, err)
+		if !assert.Equal(t, day.time(time.UTC), back, d) {
+			return
+		}
+		if d.Day != 1 {
+			assert.Equal(t, LunarDate{prev.Year, prev.Month, prev.Day + 1, prev.IsLeapMonth}, d)
+		} else if day != first {
+			assert.Contains(t, []int{29, 30}, prev.Day, d)
+		}
+		prev = d
+	}
+
+	_, err := LunarDateOf(first.addDays(-1).time(time.UTC))
+	assert.Equal(t, ErrUnSupportLunarDate, err)
+	_, err = LunarDateOf(last.addDays(1).time(time.UTC))
+	assert.Equal(t, ErrUnSupportLunarDate, err)
+}
+
+func TestLunarDateTime(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	day, err := LunarDate{2024, 8, 15, false}.Time(loc)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 9, 17, 0, 0, 0, 0, loc), day)
+
+	_, err = LunarDate{2024, 4, 1, true}.Time(ChinaStandardTime)
+	assert.True(t, errors.Is(err, ErrInvalidLunarDate))
+	_, err = LunarDate{2024, 13, 1, false}.Time(ChinaStandardTime)
+	assert.True(t, errors.Is(err, ErrInvalidLunarDate))
+	_, err = LunarDate{2024, 1, 31, false}.Time(ChinaStandardTime)
+	assert.True(t, errors.Is(err, ErrInvalidLunarDate))
+	_, err = LunarDate{2101, 1, 1, false}.Time(ChinaStandardTime)
+	assert.Equal(t, ErrUnSupportLunarDate, err)
+}
+
+func TestLunarNames(t *testing.T) {
+	d, err := LunarDateOf(date(2024, 9, 17))
+	assert.NoError(t, err)
+	assert.Equal(t, "甲辰年八月十五", d.String())
+	assert.Equal(t, "龙", d.Zodiac())
+
+	assert.Equal(t, "腊月", LunarDate{Month: 12}.MonthName())
+	assert.Equal(t, "冬月", LunarDate{Month: 11}.MonthName())
+	names := map[int]string{1: "初一", 10: "初十", 11: "十一", 19: "十九", 20: "二十", 23: "廿三", 30: "三十"}
+	for day, name := range names {
+		assert.Equal(t, name, LunarDate{Day: day}.DayName())
+	}
+	assert.Equal(t, "庚子", LunarDate{Year: 1900}.GanZhi())
+	assert.Equal(t, "鼠", LunarDate{Year: 1900}.Zodiac())
+}
@@ -0,0 +1,144 @@
+package chinesecalendar
+
+import (
+	"fmt"
+	"time"
+)
+
+// overlayKind 覆盖规则的类型
+type overlayKind int
+
+const (
+	addRestDay overlayKind = iota
+	addWorkday
+	addHalfRestDay
+	removeHoliday
+)
+
+// overlayRule 对一天的覆盖规则
+type overlayRule struct {
+	date    civilDate
+	kind    overlayKind
+	holiday Holiday
+}
+
+func newOverlayRule(t time.Time, kind overlayKind, holiday Holiday) overlayRule {
+	return overlayRule{civilDateOf(t), kind, holiday}
+}
+
+// Overlay 在已有日历上叠加的自定义安排，例如公司额外放假的年会日、12 月 24 日下午放假、需要值班的周六。
+// 规则按添加的先后顺序生效，同一天有多条规则时后添加的生效；
+// 多个 Overlay 叠加时（见 Calendar.WithOverlays），后面的 Overlay 优先。
+// 规则作用于 t 在中国的日期（见包文档），与 t 的时刻无关
+type Overlay struct {
+	rules []overlayRule
+}
+
+// NewOverlay 创建空的自定义安排
+func NewOverlay() *Overlay {
+	return &Overlay{}
+}
+
+// AddRestDay 将 t 当天设为放假，holiday 为放假的原因，例如 NewHoliday("Annual Party", "年会", 0)。
+// 当天原有的安排被替换，原为法定假日时仍计为法定假日（见 Arrangement.Statutory）
+func (o *Overlay) AddRestDay(t time.Time, holiday Holiday) *Overlay {
+	o.rules = append(o.rules, newOverlayRule(t, addRestDay, holiday))
+	return o
+}
+
+// AddWorkday 将 t 当天设为上班，holiday 为上班的原因，例如 NewHoliday("On-call", "值班", 0)。
+// t 为周末时当天按调休上班处理，为周一至周五时取消当天原有的放假安排
+func (o *Overlay) AddWorkday(t time.Time, holiday Holiday) *Overlay {
+	o.rules = append(o.rules, newOverlayRule(t, addWorkday, holiday))
+	return o
+}
+
+// AddHalfRestDay 将 t 当天设为只上半天班、另外半天放假，holiday 为放假的原因，例如 NewHoliday("Christmas Eve", "平安夜", 0)。
+// 当天仍为工作日（IsWorkday 为 true），AttendanceFor 返回 WorkHalfDay，CountWorkdaysFor 计为 0.5 天；
+// t 为周末时当天按调休上班处理，为周一至周五时取消当天原有的放假安排
+func (o *Overlay) AddHalfRestDay(t time.Time, holiday Holiday) *Overlay {
+	o.rules = append(o.rules, newOverlayRule(t, addHalfRestDay, holiday))
+	return o
+}
+
+// RemoveHoliday 取消 t 当天的放假安排，当天按周一至周五上班、周六周日休息处理。
+// 当天为调休上班日时不受影响，只放假半天时改为全天上班
+func (o *Overlay) RemoveHoliday(t time.Time) *Overlay {
+	o.rules = append(o.rules, newOverlayRule(t, removeHoliday, Holiday{}))
+	return o
+}
+
+// apply 将规则应用到以日期为索引的放假安排上
+func (o *Overlay) apply(byDay map[civilDate]Arrangement) error {
+	for _, rule := range o.rules {
+		switch rule.kind {
+		case addRestDay:
+			if rule.holiday.Name() == "" {
+				return fmt.Errorf("%w: rest day %s has no holiday", ErrInvalidArrangement, rule.date)
+			}
+			a, ok := byDay[rule.date]
+			statutory := ok && !a.Workday && a.Statutory
+			byDay[rule.date] = Arrangement{Date: rule.date.time(ChinaStandardTime), Holiday: rule.holiday, Statutory: statutory}
+		case addWorkday:
+			if rule.holiday.Name() == "" {
+				return fmt.Errorf("%w: workday %s has no holiday", ErrInvalidArrangement, rule.date)
+			}
+			if !rule.date.isWeekend() {
+				delete(byDay, rule.date)
+				continue
+			}
+			byDay[rule.date] = Arrangement{Date: rule.date.time(ChinaStandardTime), Holiday: rule.holiday, Workday: true}
+		case addHalfRestDay:
+			if rule.holiday.Name() == "" {
+				return fmt.Errorf("%w: half rest day %s has no holiday", ErrInvalidArrangement, rule.date)
+			}
+			byDay[rule.date] = Arrangement{Date: rule.date.time(ChinaStandardTime), Holiday: rule.holiday, Workday: true, HalfDay: true}
+		case removeHoliday:
+			if a, ok := byDay[rule.date]; ok {
+				switch {
+				case !a.Workday:
+					delete(byDay, rule.date)
+				case a.HalfDay && rule.date.isWeekend():
+					a.HalfDay = false
+					byDay[rule.date] = a
+				case a.HalfDay:
+					delete(byDay, rule.date)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// WithOverlays 返回叠加了 overlays 的新日历，c 本身不变。
+// 新日历的日期范围与 c 相同，所有查询都按叠加后的安排回答；
+// 规则的日期超出 c 的支持范围时返回 *DateRangeError，放假、上班缺少原因时返回 ErrInvalidArrangement
+func (c *Calendar) WithOverlays(overlays ...*Overlay) (*Calendar, error) {
+	byDay := make(map[civilDate]Arrangement)
+	for _, a := range c.Arrangements() {
+		byDay[civilDateOf(a.Date)] = a
+	}
+	for _, o := range overlays {
+		for _, rule := range o.rules {
+			if !c.isSupported(rule.date) {
+				return nil, c.rangeError(rule.date, ChinaStandardTime)
+			}
+		}
+		if err := o.apply(byDay); err != nil {
+			return nil, err
+		}
+	}
+
+	arrangements := make([]Arrangement, 0, len(byDay))
+	for _, a := range byDay {
+		arrangements = append(arrangements, a)
+	}
+	overlaid, err := NewCalendar(c.minDay.time(ChinaStandardTime), c.maxDay.time(ChinaStandardTime), arrangements)
+	if err != nil {
+		return nil, err
+	}
+	overlaid.projected = c.projected
+	overlaid.groups = c.groups
+	overlaid.feed = c.subFeed("overlay")
+	return overlaid, nil
+}
@@ -0,0 +1,246 @@
+package chinesecalendar
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	annualParty  = NewHoliday("Annual Party", "年会", 0)
+	onCall       = NewHoliday("On-call", "值班", 0)
+	christmasEve = NewHoliday("Christmas Eve", "平安夜", 0)
+)
+
+func TestWithOverlays(t *testing.T) {
+	o := NewOverlay().
+		AddRestDay(time.Date(2024, 2, 2, 0, 0, 0, 0, ChinaStandardTime), annualParty).
+		AddWorkday(time.Date(2024, 3, 2, 0, 0, 0, 0, ChinaStandardTime), onCall).
+		RemoveHoliday(time.Date(2024, 10, 7, 0, 0, 0, 0, ChinaStandardTime))
+	c, err := Default().WithOverlays(o)
+	assert.NoError(t, err)
+
+	holiday, isHoliday := c.GetHolidayDetail(time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, true, isHoliday)
+	assert.Equal(t, annualParty, holiday)
+	dayType, err := c.GetDayType(time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, AdjustedRestDay, dayType)
+
+	assert.Equal(t, true, c.IsWorkday(time.Date(2024, 3, 2, 0, 0, 0, 0, ChinaStandardTime)))
+	dayType, err = c.GetDayType(time.Date(2024, 3, 2, 0, 0, 0, 0, ChinaStandardTime))
+	assert.NoError(t, err)
+	assert.Equal(t, AdjustedWorkday, dayType)
+
+	assert.Equal(t, true, c.IsWorkday(time.Date(2024, 10, 7, 0, 0, 0, 0, ChinaStandardTime)))
+	next, err := c.NextWorkday(time.Date(2024, 10, 1, 0, 0, 0, 0, ChinaStandardTime))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 10, 7, 0, 0, 0, 0, ChinaStandardTime), next)
+
+	// 原日历不变
+	assert.Equal(t, true, IsWorkday(time.Date(2024, 2, 2, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.Equal(t, true, IsHoliday(time.Date(2024, 10, 7, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.NotEqual(t, Default().Version(), c.Version())
+
+	n, err := c.CountWorkdays(time.Date(2024, 1, 1, 0, 0, 0, 0, ChinaStandardTime), time.Date(2024, 10, 12, 0, 0, 0, 0, ChinaStandardTime))
+	assert.NoError(t, err)
+	official, _ := CountWorkdays(time.Date(2024, 1, 1, 0, 0, 0, 0, ChinaStandardTime), time.Date(2024, 10, 12, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, official+1, n)
+}
+
+func TestOverlayPrecedence(t *testing.T) {
+	day := time.Date(2024, 9, 30, 0, 0, 0, 0, ChinaStandardTime)
+
+	// 同一个 Overlay 内后添加的规则生效
+	c, err := Default().WithOverlays(NewOverlay().AddRestDay(day, annualParty).RemoveHoliday(day))
+	assert.NoError(t, err)
+	assert.Equal(t, true, c.IsWorkday(day))
+
+	// 后面的 Overlay 优先
+	first := NewOverlay().AddRestDay(day, annualParty)
+	c, err = Default().WithOverlays(NewOverlay().RemoveHoliday(day), first)
+	assert.NoError(t, err)
+	assert.Equal(t, true, c.IsHoliday(day))
+	period, ok := c.GetHolidayPeriod(time.Date(2024, 10, 1, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, day, period.Start)
+	assert.Equal(t, []Holiday{annualParty, NationalDay}, period.Holidays)
+
+	c, err = Default().WithOverlays(first, NewOverlay().AddWorkday(day, onCall))
+	assert.NoError(t, err)
+	assert.Equal(t, true, c.IsWorkday(day))
+
+	// 调休上班日不受 RemoveHoliday 影响
+	c, err = Default().WithOverlays(NewOverlay().RemoveHoliday(time.Date(2024, 10, 12, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.NoError(t, err)
+	assert.Equal(t, true, c.IsWorkday(time.Date(2024, 10, 12, 0, 0, 0, 0, ChinaStandardTime)))
+}
+
+func TestOverlayErrors(t *testing.T) {
+	_, err := Default().WithOverlays(NewOverlay().AddRestDay(time.Date(2025, 1, 2, 0, 0, 0, 0, ChinaStandardTime), annualParty))
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+	_, err = Default().WithOverlays(NewOverlay().AddRestDay(time.Date(2024, 1, 2, 0, 0, 0, 0, ChinaStandardTime), Holiday{}))
+	assert.ErrorIs(t, err, ErrInvalidArrangement)
+	_, err = Default().WithOverlays(NewOverlay().AddWorkday(time.Date(2024, 3, 2, 0, 0, 0, 0, ChinaStandardTime), Holiday{}))
+	assert.ErrorIs(t, err, ErrInvalidArrangement)
+	// 周一至周五上班同样需要原因
+	_, err = Default().WithOverlays(NewOverlay().AddWorkday(time.Date(2024, 10, 7, 0, 0, 0, 0, ChinaStandardTime), Holiday{}))
+	assert.ErrorIs(t, err, ErrInvalidArrangement)
+	_, err = Default().WithOverlays(NewOverlay().AddHalfRestDay(time.Date(2024, 3, 8, 0, 0, 0, 0, ChinaStandardTime), Holiday{}))
+	assert.ErrorIs(t, err, ErrInvalidArrangement)
+}
+
+// TestOverlayCivilDate 规则按 t 在中国的日期生效，与 t 的时刻、时区无关
+func TestOverlayCivilDate(t *testing.T) {
+	c, err := Default().WithOverlays(NewOverlay().
+		AddRestDay(time.Date(2024, 9, 30, 13, 0, 0, 0, ChinaStandardTime), annualParty).
+		RemoveHoliday(time.Date(2024, 10, 6, 20, 0, 0, 0, time.UTC)))
+	assert.NoError(t, err)
+	assert.Equal(t, true, c.IsHoliday(time.Date(2024, 9, 30, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.Equal(t, true, c.IsWorkday(time.Date(2024, 10, 7, 0, 0, 0, 0, ChinaStandardTime)))
+
+	// 智利、古巴夏令时在零点开始，当天零点不存在，time.Date 返回的不是零点，但在中国仍是同一天
+	for _, tt := range []struct {
+		zone string
+		day  civilDate
+	}{
+		{"America/Santiago", civilDate{2024, time.September, 8}},
+		{"America/Havana", civilDate{2024, time.March, 10}},
+	} {
+		loc, err := time.LoadLocation(tt.zone)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		day := tt.day.time(loc)
+		assert.NotEqual(t, 0, day.Hour(), tt.zone)
+		assert.Equal(t, tt.day, civilDateOf(day), tt.zone)
+		c, err := Default().WithOverlays(NewOverlay().AddWorkday(day, onCall))
+		assert.NoError(t, err, tt.zone)
+		assert.Equal(t, true, c.IsWorkday(tt.day.time(ChinaStandardTime)), tt.zone)
+		c, err = Default().WithOverlays(NewOverlay().AddHalfRestDay(day, christmasEve))
+		assert.NoError(t, err, tt.zone)
+		attendance, err := c.AttendanceFor(tt.day.time(ChinaStandardTime), 0)
+		assert.NoError(t, err)
+		assert.Equal(t, WorkHalfDay, attendance, tt.zone)
+	}
+}
+
+func TestOverlayHalfRestDay(t *testing.T) {
+	day := time.Date(2021, 12, 24, 0, 0, 0, 0, ChinaStandardTime)
+	c, err := Default().WithOverlays(NewOverlay().AddHalfRestDay(day, christmasEve))
+	assert.NoError(t, err)
+
+	// 当天仍为工作日，出勤半天
+	assert.Equal(t, true, c.IsWorkday(day))
+	dayType, err := c.GetDayType(day)
+	assert.NoError(t, err)
+	assert.Equal(t, Workday, dayType)
+	_, isHoliday := c.GetHolidayDetail(day)
+	assert.Equal(t, false, isHoliday)
+	holiday, ok := c.GetHolidayDetailFor(day, 0)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, christmasEve, holiday)
+	for _, group := range []Group{0, Women, Children} {
+		attendance, err := c.AttendanceFor(day, group)
+		assert.NoError(t, err)
+		assert.Equal(t, WorkHalfDay, attendance, group)
+	}
+	assert.Contains(t, c.Arrangements(), Arrangement{Date: day, Holiday: christmasEve, Workday: true, HalfDay: true})
+
+	start, end := time.Date(2021, 12, 1, 0, 0, 0, 0, ChinaStandardTime), time.Date(2021, 12, 31, 0, 0, 0, 0, ChinaStandardTime)
+	workdays, err := c.CountWorkdays(start, end)
+	assert.NoError(t, err)
+	official, _ := CountWorkdays(start, end)
+	assert.Equal(t, official, workdays)
+	days, err := c.CountWorkdaysFor(start, end, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(official)-0.5, days)
+
+	// 与部分公民放假的节日同一天时取休息较多的
+	womensDay, childrensDay := time.Date(2024, 3, 8, 0, 0, 0, 0, ChinaStandardTime), time.Date(2023, 6, 1, 0, 0, 0, 0, ChinaStandardTime)
+	c, err = Default().WithOverlays(NewOverlay().AddHalfRestDay(womensDay, annualParty).AddHalfRestDay(childrensDay, annualParty))
+	assert.NoError(t, err)
+	attendance, _ := c.AttendanceFor(womensDay, Women)
+	assert.Equal(t, WorkHalfDay, attendance)
+	attendance, _ = c.AttendanceFor(childrensDay, Children)
+	assert.Equal(t, RestAllDay, attendance)
+	holiday, _ = c.GetHolidayDetailFor(womensDay, Women)
+	assert.Equal(t, WomensDay, holiday)
+
+	// 休息日改为上半天班：法定假日当天上班，周六按调休上班处理
+	holidayDay, saturday := time.Date(2024, 10, 4, 0, 0, 0, 0, ChinaStandardTime), time.Date(2024, 3, 2, 0, 0, 0, 0, ChinaStandardTime)
+	c, err = Default().WithOverlays(NewOverlay().AddHalfRestDay(holidayDay, annualParty).AddHalfRestDay(saturday, onCall))
+	assert.NoError(t, err)
+	assert.Equal(t, true, c.IsWorkday(holidayDay))
+	assert.Equal(t, true, c.IsWorkday(saturday))
+	dayType, _ = c.GetDayType(saturday)
+	assert.Equal(t, AdjustedWorkday, dayType)
+	attendance, _ = c.AttendanceFor(saturday, 0)
+	assert.Equal(t, WorkHalfDay, attendance)
+
+	// 后添加的规则覆盖半天假
+	c, err = Default().WithOverlays(
+		NewOverlay().AddHalfRestDay(day, christmasEve).AddHalfRestDay(saturday, onCall),
+		NewOverlay().RemoveHoliday(day).RemoveHoliday(saturday))
+	assert.NoError(t, err)
+	for _, d := range []time.Time{day, saturday} {
+		attendance, _ = c.AttendanceFor(d, 0)
+		assert.Equal(t, WorkFullDay, attendance, d)
+	}
+	c, err = Default().WithOverlays(NewOverlay().AddHalfRestDay(day, christmasEve).AddRestDay(day, christmasEve))
+	assert.NoError(t, err)
+	assert.Equal(t, true, c.IsHoliday(day))
+
+	_, err = NewCalendar(day, day, []Arrangement{{Date: day, Holiday: christmasEve, HalfDay: true}})
+	assert.ErrorIs(t, err, ErrInvalidArrangement)
+}
+
+// TestHalfRestDayRoundTrip 半天假可以通过 JSON、iCalendar 完整读回
+func TestHalfRestDayRoundTrip(t *testing.T) {
+	day, saturday := time.Date(2021, 12, 24, 0, 0, 0, 0, ChinaStandardTime), time.Date(2024, 3, 2, 0, 0, 0, 0, ChinaStandardTime)
+	c, err := Default().WithOverlays(NewOverlay().AddHalfRestDay(day, christmasEve).AddHalfRestDay(saturday, onCall))
+	assert.NoError(t, err)
+	assert.NotEqual(t, Default().Version(), c.Version())
+
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, c.WriteJSON(buffer))
+	assert.Contains(t, buffer.String(), `{"date":"2021-12-24","year":2021,"type":"workday","holiday":"christmas_eve","name":"平安夜","engName":"Christmas Eve","halfDay":true}`)
+	loaded, err := LoadJSON(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, c.tables, loaded.tables)
+
+	buffer.Reset()
+	assert.NoError(t, c.WriteICS(buffer, day, saturday))
+	assert.Contains(t, buffer.String(), "SUMMARY:平安夜 休半天 / Christmas Eve (Half Day Off)\r\n")
+	loaded, err = LoadICS(buffer, nil)
+	assert.NoError(t, err)
+	for _, d := range []time.Time{day, saturday} {
+		attendance, err := loaded.AttendanceFor(d, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, WorkHalfDay, attendance, d)
+		assert.Equal(t, true, loaded.IsWorkday(d), d)
+	}
+}
+
+func TestOverlayStatutory(t *testing.T) {
+	day := time.Date(2024, 10, 1, 0, 0, 0, 0, ChinaStandardTime)
+	c, err := Default().WithOverlays(NewOverlay().AddRestDay(day, annualParty))
+	assert.NoError(t, err)
+	holiday, _ := c.GetHolidayDetail(day)
+	assert.Equal(t, annualParty, holiday)
+	dayType, err := c.GetDayType(day)
+	assert.NoError(t, err)
+	assert.Equal(t, StatutoryHoliday, dayType)
+	period, _ := c.GetHolidayPeriod(day)
+	assert.Contains(t, period.StatutoryDays, day)
+
+	// 原为补休的日期不是法定假日
+	day = time.Date(2024, 10, 4, 0, 0, 0, 0, ChinaStandardTime)
+	c, err = Default().WithOverlays(NewOverlay().AddRestDay(day, annualParty))
+	assert.NoError(t, err)
+	dayType, err = c.GetDayType(day)
+	assert.NoError(t, err)
+	assert.Equal(t, AdjustedRestDay, dayType)
+}
@@ -0,0 +1,183 @@
+package chinesecalendar
+
+import (
+	"sort"
+	"time"
+)
+
+// HolidayPeriod 一次连续放假。
+// 由放假安排中的日期及与之相连的休息日（周末）组成，
+// 例如 2024 年端午节 6 月 10 日放假、与周末连休，对应的放假区间为 6 月 8 日至 10 日；
+// 2023 年中秋节、国庆节相连，9 月 29 日至 10 月 6 日为同一个区间。
+type HolidayPeriod struct {
+	// Holidays 区间内的节日，按时间先后排列
+	Holidays []Holiday
+	// Start 第一天
+	Start time.Time
+	// End 最后一天
+	End time.Time
+	// Workdays 为这次放假调休上班的日期
+	Workdays []time.Time
+	// InLieuDays 区间内的替代日
+	InLieuDays []time.Time
+	// StatutoryDays 区间内的法定假日
+	StatutoryDays []time.Time
+}
+
+// Days 放假天数
+func (p HolidayPeriod) Days() int {
+	return civilDateOf(p.Start).daysUntil(civilDateOf(p.End)) + 1
+}
+
+// holidayPeriod 以 civilDate 表示的 HolidayPeriod
+type holidayPeriod struct {
+	holidays   []Holiday
+	start, end civilDate
+	workdays   []civilDate
+	inLieuDays []civilDate
+	statutory  []civilDate
+}
+
+func (p *holidayPeriod) contains(d civilDate) bool {
+	return !d.before(p.start) && !d.after(p.end)
+}
+
+func (p *holidayPeriod) hasHoliday(h Holiday) bool {
+	for _, holiday := range p.holidays {
+		if holiday == h {
+			return true
+		}
+	}
+	return false
+}
+
+// distance 返回 d 到区间的天数
+func (p *holidayPeriod) distance(d civilDate) int {
+	if d.before(p.start) {
+		return d.daysUntil(p.start)
+	}
+	return p.end.daysUntil(d)
+}
+
+func (p *holidayPeriod) export(loc *time.Location) HolidayPeriod {
+	toTimes := func(days []civilDate) []time.Time {
+		list := make([]time.Time, 0, len(days))
+		for _, d := range days {
+			list = append(list, d.time(loc))
+		}
+		return list
+	}
+	return HolidayPeriod{
+		Holidays:      append([]Holiday{}, p.holidays...),
+		Start:         p.start.time(loc),
+		End:           p.end.time(loc),
+		Workdays:      toTimes(p.workdays),
+		InLieuDays:    toTimes(p.inLieuDays),
+		StatutoryDays: toTimes(p.statutory),
+	}
+}
+
+// allPeriods 返回支持范围内的全部放假区间，按时间先后排列
+func (c *Calendar) allPeriods() []*holidayPeriod {
+	c.periodsOnce.Do(func() {
+		c.periods = c.buildPeriods()
+	})
+	return c.periods
+}
+
+// buildPeriods 将连续的休息日划为一个区间，区间内至少有一天在放假安排中；
+// 调休上班日归入时间上最近的、包含其所属节日的区间
+func (c *Calendar) buildPeriods() []*holidayPeriod {
+	var list []*holidayPeriod
+	var workdays []civilDate
+	var current *holidayPeriod
+	var pending []civilDate // current 为空时，尚未确定是否属于放假区间的休息日
+
+	for d := c.minDay; !d.after(c.maxDay); d = d.addDays(1) {
+		tb, i := c.tableOf(d)
+		if tb.isWorkday(i) {
+			if tb.workdays.has(i) {
+				workdays = append(workdays, d)
+			}
+			current, pending = nil, nil
+			continue
+		}
+		if !tb.holidays.has(i) {
+			if current != nil {
+				current.end = d
+			} else {
+				pending = append(pending, d)
+			}
+			continue
+		}
+
+		if current == nil {
+			current = &holidayPeriod{start: d}
+			if len(pending) > 0 {
+				current.start = pending[0]
+			}
+			list = append(list, current)
+		}
+		current.end = d
+		if holiday, _ := tb.holidayAt(i); !current.hasHoliday(holiday) {
+			current.holidays = append(current.holidays, holiday)
+		}
+		if tb.inLieuDays.has(i) {
+			current.inLieuDays = append(current.inLieuDays, d)
+		}
+		if tb.statutory.has(i) {
+			current.statutory = append(current.statutory, d)
+		}
+	}
+
+	for _, d := range workdays {
+		tb, i := c.tableOf(d)
+		holiday, _ := tb.holidayAt(i)
+		var nearest *holidayPeriod
+		for _, p := range list {
+			if p.hasHoliday(holiday) && (nearest == nil || p.distance(d) < nearest.distance(d)) {
+				nearest = p
+			}
+		}
+		if nearest != nil {
+			nearest.workdays = append(nearest.workdays, d)
+		}
+	}
+	for _, p := range list {
+		sort.Slice(p.workdays, func(i, j int) bool { return p.workdays[i].before(p.workdays[j]) })
+	}
+	return list
+}
+
+// GetHolidayPeriod 获取 t 所在的放假区间，t 不在任何放假区间内时返回 false。
+// 返回的日期为 ChinaStandardTime 的零点
+func (c *Calendar) GetHolidayPeriod(t time.Time) (HolidayPeriod, bool) {
+	d, isValidate := c.validateDate(t)
+	if !isValidate {
+		return HolidayPeriod{}, false
+	}
+
+	list := c.allPeriods()
+	n := sort.Search(len(list), func(i int) bool { return !list[i].end.before(d) })
+	if n < len(list) && list[n].contains(d) {
+		return list[n].export(ChinaStandardTime), true
+	}
+	return HolidayPeriod{}, false
+}
+
+// GetHolidayPeriods 获取某一年的全部放假区间，按结束日期所在年份归属，
+// 例如 2018 年 12 月 30 日至 2019 年 1 月 1 日的元旦假期属于 2019 年。
+// 返回的日期为 ChinaStandardTime 时区的零点
+func (c *Calendar) GetHolidayPeriods(year int) ([]HolidayPeriod, error) {
+	if year < c.minDay.year || year > c.maxDay.year {
+		return []HolidayPeriod{}, c.rangeError(civilDate{year, time.January, 1}, ChinaStandardTime)
+	}
+
+	list := make([]HolidayPeriod, 0)
+	for _, p := range c.allPeriods() {
+		if p.end.year == year {
+			list = append(list, p.export(ChinaStandardTime))
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,78 @@
+package chinesecalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetHolidayPeriod(t *testing.T) {
+	period, ok := GetHolidayPeriod(time.Date(2024, 10, 3, 12, 0, 0, 0, time.UTC))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, []Holiday{NationalDay}, period.Holidays)
+	assert.Equal(t, time.Date(2024, 10, 1, 0, 0, 0, 0, ChinaStandardTime), period.Start)
+	assert.Equal(t, time.Date(2024, 10, 7, 0, 0, 0, 0, ChinaStandardTime), period.End)
+	assert.Equal(t, 7, period.Days())
+	assert.Equal(t, []time.Time{
+		time.Date(2024, 9, 29, 0, 0, 0, 0, ChinaStandardTime),
+		time.Date(2024, 10, 12, 0, 0, 0, 0, ChinaStandardTime),
+	}, period.Workdays)
+	assert.Equal(t, []time.Time{
+		time.Date(2024, 10, 4, 0, 0, 0, 0, ChinaStandardTime),
+		time.Date(2024, 10, 7, 0, 0, 0, 0, ChinaStandardTime),
+	}, period.InLieuDays)
+
+	// 与周末连休
+	period, ok = GetHolidayPeriod(time.Date(2024, 6, 8, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, []Holiday{DragonBoatFestival}, period.Holidays)
+	assert.Equal(t, time.Date(2024, 6, 10, 0, 0, 0, 0, ChinaStandardTime), period.End)
+	assert.Equal(t, 3, period.Days())
+	assert.Empty(t, period.Workdays)
+
+	// 中秋节、国庆节相连
+	period, ok = GetHolidayPeriod(time.Date(2023, 10, 3, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, []Holiday{MidAutumnFestival, NationalDay}, period.Holidays)
+	assert.Equal(t, time.Date(2023, 9, 29, 0, 0, 0, 0, ChinaStandardTime), period.Start)
+	assert.Equal(t, 8, period.Days())
+	assert.Equal(t, 4, len(period.StatutoryDays))
+	assert.Equal(t, 2, len(period.Workdays))
+
+	// 跨年的元旦假期
+	period, ok = GetHolidayPeriod(time.Date(2019, 1, 1, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, time.Date(2018, 12, 30, 0, 0, 0, 0, ChinaStandardTime), period.Start)
+	assert.Equal(t, []time.Time{time.Date(2018, 12, 29, 0, 0, 0, 0, ChinaStandardTime)}, period.Workdays)
+
+	_, ok = GetHolidayPeriod(time.Date(2024, 3, 9, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, false, ok)
+	_, ok = GetHolidayPeriod(time.Date(2024, 10, 8, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, false, ok)
+}
+
+func TestGetHolidayPeriods(t *testing.T) {
+	periods, err := GetHolidayPeriods(2019)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, len(periods))
+	assert.Equal(t, []Holiday{NewYearsDay}, periods[0].Holidays)
+	assert.Equal(t, time.Date(2018, 12, 30, 0, 0, 0, 0, ChinaStandardTime), periods[0].Start)
+
+	periods, err = GetHolidayPeriods(2024)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, len(periods))
+	assert.Equal(t, time.Date(2023, 12, 30, 0, 0, 0, 0, ChinaStandardTime), periods[0].Start)
+	assert.Equal(t, []Holiday{NationalDay}, periods[6].Holidays)
+
+	_, err = GetHolidayPeriods(2001)
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+}
+
+func TestAntiFascist70thDayPeriod(t *testing.T) {
+	period, ok := GetHolidayPeriod(time.Date(2015, 9, 4, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, []Holiday{AntiFascist70thDay}, period.Holidays)
+	assert.Equal(t, 3, period.Days())
+	assert.Equal(t, []time.Time{time.Date(2015, 9, 3, 0, 0, 0, 0, ChinaStandardTime)}, period.StatutoryDays)
+}
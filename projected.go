@@ -0,0 +1,123 @@
+package chinesecalendar
+
+import (
+	"sort"
+	"time"
+)
+
+// ProjectedHoliday 按《全国年节及纪念日放假办法》推算的一天法定假日。
+// 推算结果不是国务院公布的放假安排：不包括调休、补休，公布后应以正式安排为准
+type ProjectedHoliday struct {
+	// Date 日期，为 ChinaStandardTime 时区的零点
+	Date time.Time
+	// Holiday 所属的节日
+	Holiday Holiday
+}
+
+// holidayRule 节日的推算规则，first 返回 year 年第一天法定假日的日期
+type holidayRule struct {
+	holiday Holiday
+	first   func(year int) civilDate
+}
+
+// holidayRules 法定节日的推算规则。
+// 中秋节与国庆节重合时（如 2020 年）该日计为国庆节，与正式安排一致，因此国庆节排在中秋节之前
+var holidayRules = []holidayRule{
+	{NewYearsDay, fixedDay(time.January, 1)},
+	{SpringFestival, springFestivalFirst},
+	{TombSweepingDay, func(year int) civilDate { return solarTermDay(year, PureBrightness) }},
+	{LabourDay, fixedDay(time.May, 1)},
+	{DragonBoatFestival, lunarDay(5, 5)},
+	{NationalDay, fixedDay(time.October, 1)},
+	{MidAutumnFestival, lunarDay(8, 15)},
+}
+
+func fixedDay(month time.Month, day int) func(year int) civilDate {
+	return func(year int) civilDate {
+		return civilDate{year, month, day}
+	}
+}
+
+// lunarDay 农历 month 月 day 日，农历年与公历年相同
+func lunarDay(month, day int) func(year int) civilDate {
+	return func(year int) civilDate {
+		t, _ := LunarDate{Year: year, Month: month, Day: day}.Time(time.UTC)
+		return civilDateOf(t)
+	}
+}
+
+// springFestivalFirst 春节法定假日为正月初一至初三，4 天时（2025 年起）从除夕开始
+func springFestivalFirst(year int) civilDate {
+	d := lunarDay(1, 1)(year)
+	if days := SpringFestival.StatutoryDays(year); days > 3 {
+		d = d.addDays(3 - days)
+	}
+	return d
+}
+
+// projectedYearSupported 推算需要农历与节气数据
+func projectedYearSupported(year int) bool {
+	_, ok := solarTermTime(year, PureBrightness)
+	return ok && lunarYearOf(year) != nil
+}
+
+// ProjectHolidays 按节日规则推算 year 年的法定假日，按日期排列。
+// 农历节日按农历、清明按节气推算，天数为该年规定的法定假日天数（见 Holiday.StatutoryDays），
+// 春节按 2014 年起的规则（正月初一至初三，2025 年起加除夕），一次性的纪念日不推算。
+// 支持 1900 年至 2100 年，超出范围时返回 ErrUnSupportProjectedYear
+func ProjectHolidays(year int) ([]ProjectedHoliday, error) {
+	if !projectedYearSupported(year) {
+		return []ProjectedHoliday{}, ErrUnSupportProjectedYear
+	}
+
+	byDay := make(map[civilDate]Holiday)
+	days := make([]civilDate, 0)
+	for _, rule := range holidayRules {
+		d := rule.first(year)
+		for n := 0; n < rule.holiday.StatutoryDays(year); n++ {
+			if _, ok := byDay[d]; !ok {
+				byDay[d] = rule.holiday
+				days = append(days, d)
+			}
+			d = d.addDays(1)
+		}
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].before(days[j]) })
+
+	list := make([]ProjectedHoliday, 0, len(days))
+	for _, d := range days {
+		list = append(list, ProjectedHoliday{d.time(ChinaStandardTime), byDay[d]})
+	}
+	return list, nil
+}
+
+// ProjectCalendar 用 ProjectHolidays 的结果构建 startYear 至 endYear（含）全年的日历，
+// 法定假日放假，其余日期按周一至周五上班、周六周日休息处理，没有调休。
+// 返回的日历 Projected 为 true，与内置的正式安排相互独立，可用于正式安排公布前的规划
+func ProjectCalendar(startYear, endYear int) (*Calendar, error) {
+	arrangements := make([]Arrangement, 0)
+	for year := startYear; year <= endYear; year++ {
+		list, err := ProjectHolidays(year)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range list {
+			arrangements = append(arrangements, Arrangement{Date: h.Date, Holiday: h.Holiday, Statutory: true})
+		}
+	}
+
+	start := time.Date(startYear, time.January, 1, 0, 0, 0, 0, ChinaStandardTime)
+	end := time.Date(endYear, time.December, 31, 0, 0, 0, 0, ChinaStandardTime)
+	c, err := NewCalendar(start, end, arrangements)
+	if err != nil {
+		return nil, err
+	}
+	c.projected = true
+	c.feed = "projected"
+	return c, nil
+}
+
+// Projected 日历是否为推算的结果（见 ProjectCalendar），而不是正式公布的放假安排
+func (c *Calendar) Projected() bool {
+	return c.projected
+}
@@ -0,0 +1,72 @@
+package chinesecalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectHolidays(t *testing.T) {
+	// 2014 年起的推算结果与正式安排中的法定假日一致
+	official := make(map[int][]ProjectedHoliday)
+	for _, a := range Default().Arrangements() {
+		if a.Statutory && a.Date.Year() >= 2014 && a.Holiday != AntiFascist70thDay {
+			official[a.Date.Year()] = append(official[a.Date.Year()], ProjectedHoliday{a.Date, a.Holiday})
+		}
+	}
+	for year := 2014; year <= 2024; year++ {
+		list, err := ProjectHolidays(year)
+		assert.NoError(t, err)
+		assert.Equal(t, official[year], list, year)
+	}
+
+	// 2025 年春节包括除夕，劳动节 2 天
+	list, err := ProjectHolidays(2025)
+	assert.NoError(t, err)
+	assert.Equal(t, 13, len(list))
+	dates := make(map[string]string)
+	for _, h := range list {
+		dates[h.Date.Format("2006-01-02")] = h.Holiday.Name()
+	}
+	assert.Equal(t, map[string]string{
+		"2025-01-01": "元旦",
+		"2025-01-28": "春节", "2025-01-29": "春节", "2025-01-30": "春节", "2025-01-31": "春节",
+		"2025-04-04": "清明",
+		"2025-05-01": "劳动节", "2025-05-02": "劳动节",
+		"2025-05-31": "端午",
+		"2025-10-01": "国庆节", "2025-10-02": "国庆节", "2025-10-03": "国庆节",
+		"2025-10-06": "中秋",
+	}, dates)
+
+	_, err = ProjectHolidays(1899)
+	assert.Equal(t, ErrUnSupportProjectedYear, err)
+	_, err = ProjectHolidays(2101)
+	assert.Equal(t, ErrUnSupportProjectedYear, err)
+}
+
+func TestProjectCalendar(t *testing.T) {
+	assert.False(t, Default().Projected())
+
+	c, err := ProjectCalendar(2026, 2027)
+	assert.NoError(t, err)
+	assert.True(t, c.Projected())
+
+	// 2026 年春节为 2 月 17 日，除夕 2 月 16 日
+	assert.True(t, c.IsHoliday(time.Date(2026, 2, 16, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.True(t, c.IsHoliday(time.Date(2026, 2, 19, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.True(t, c.IsWorkday(time.Date(2026, 2, 20, 0, 0, 0, 0, ChinaStandardTime)))
+	dayType, err := c.GetDayType(time.Date(2026, 2, 17, 0, 0, 0, 0, ChinaStandardTime))
+	assert.NoError(t, err)
+	assert.Equal(t, StatutoryHoliday, dayType)
+
+	// 没有调休与补休，落在周末的清明（4 月 5 日）、5 月 2 日、10 月 3 日不另外放假
+	n, err := c.CountWorkdays(time.Date(2026, 1, 1, 0, 0, 0, 0, ChinaStandardTime), time.Date(2026, 12, 31, 0, 0, 0, 0, ChinaStandardTime))
+	assert.NoError(t, err)
+	assert.Equal(t, 261-10, n)
+
+	_, err = c.GetDayType(time.Date(2028, 1, 1, 0, 0, 0, 0, ChinaStandardTime))
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+	_, err = ProjectCalendar(2100, 2101)
+	assert.Equal(t, ErrUnSupportProjectedYear, err)
+}
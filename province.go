@@ -0,0 +1,100 @@
+package chinesecalendar
+
+import "fmt"
+
+// Province 规定了地方节日的省、自治区
+type Province int
+
+const (
+	// Xinjiang 新疆维吾尔自治区，肉孜节放假 1 天，古尔邦节放假 2 天
+	Xinjiang Province = iota
+	// Tibet 西藏自治区，藏历新年放假 3 天
+	Tibet
+	// Guangxi 广西壮族自治区，壮族三月三放假 2 天
+	Guangxi
+)
+
+var provinceNames = [...]string{"新疆", "西藏", "广西"}
+
+// provinceFeeds 各省、自治区日历的标识，见 Calendar.WriteICS
+var provinceFeeds = [...]string{"xinjiang", "tibet", "guangxi"}
+
+func (p Province) String() string {
+	if p < 0 || int(p) >= len(provinceNames) {
+		return "未知"
+	}
+	return provinceNames[p]
+}
+
+// 省、自治区的地方节日定义，days 为当地规定的放假天数
+var (
+	EidAlFitr         = Holiday{"Eid al-Fitr", "肉孜节", 1, ProvincialScope}
+	EidAlAdha         = Holiday{"Eid al-Adha", "古尔邦节", 2, ProvincialScope}
+	TibetanNewYear    = Holiday{"Losar", "藏历新年", 3, ProvincialScope}
+	SanyuesanFestival = Holiday{"Sanyuesan Festival", "三月三", 2, ProvincialScope}
+)
+
+// provinceDay 地方节日放假的一天
+type provinceDay struct {
+	date    civilDate
+	holiday Holiday
+}
+
+// WithProvince 返回叠加了 province 地方节日的新日历，c 本身不变。
+// 地方节日只有 2023 年至 2025 年的数据，新日历的日期范围为 c 的日期范围与之重叠的部分，
+// 其他日期返回 *DateRangeError；c 的日期范围与之没有重叠时返回 *DateRangeError。
+// 地方节日标记为法定假日（Holiday.Scope 为 ProvincialScope），当地另行公布的调休不包括在内。
+// 地方节日当天已有全国的放假安排时以全国的安排为准，地方节日不另外放假、也不顺延，
+// 例如广西 2023 年三月三的第二天（4 月 23 日）为劳动节的调休上班日，当天仍然上班。
+// province 不是已知的省、自治区时返回 ErrUnknownProvince
+func (c *Calendar) WithProvince(province Province) (*Calendar, error) {
+	days, ok := provinceHolidays[province]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownProvince, int(province))
+	}
+	start, end := c.minDay, c.maxDay
+	if start.before(provinceMinDay) {
+		start = provinceMinDay
+	}
+	if end.after(provinceMaxDay) {
+		end = provinceMaxDay
+	}
+	if end.before(start) {
+		return nil, &DateRangeError{
+			Date: c.minDay.time(ChinaStandardTime),
+			Min:  provinceMinDay.time(ChinaStandardTime),
+			Max:  provinceMaxDay.time(ChinaStandardTime),
+		}
+	}
+
+	var arrangements []Arrangement
+	arranged := make(map[civilDate]bool)
+	for _, a := range c.Arrangements() {
+		d := civilDateOf(a.Date)
+		if !d.before(start) && !d.after(end) {
+			arrangements = append(arrangements, a)
+			arranged[d] = true
+		}
+	}
+	for _, d := range days {
+		if !d.date.before(start) && !d.date.after(end) && !arranged[d.date] {
+			arrangements = append(arrangements, Arrangement{Date: d.date.time(ChinaStandardTime), Holiday: d.holiday, Statutory: true})
+		}
+	}
+
+	layered, err := NewCalendar(start.time(ChinaStandardTime), end.time(ChinaStandardTime), arrangements)
+	if err != nil {
+		return nil, err
+	}
+	layered.projected = c.projected
+	layered.groups = c.groups
+	layered.feed = c.subFeed(provinceFeeds[province])
+	return layered, nil
+}
+
+// ProvinceCalendar 返回在内置的全国放假安排上叠加了 province 地方节日的日历，见 Calendar.WithProvince。
+// 日期范围为 2023 年 1 月 1 日至内置数据的最后一天。
+// 地方节日的数据由 scripts/generator 生成
+func ProvinceCalendar(province Province) (*Calendar, error) {
+	return defaultCalendar.WithProvince(province)
+}
@@ -0,0 +1,43 @@
+// Code generated by "scripts/generator"; DO NOT EDIT.
+
+package chinesecalendar
+
+// provinceMinDay、provinceMaxDay 地方节日数据覆盖的第一天与最后一天
+var (
+	provinceMinDay = civilDate{2023, 1, 1}
+	provinceMaxDay = civilDate{2025, 12, 31}
+)
+
+// provinceHolidays 各省、自治区的地方节日，见 Calendar.WithProvince
+var provinceHolidays = map[Province][]provinceDay{
+	Xinjiang: {
+		{civilDate{2023, 4, 22}, EidAlFitr},
+		{civilDate{2023, 6, 29}, EidAlAdha},
+		{civilDate{2023, 6, 30}, EidAlAdha},
+		{civilDate{2024, 4, 10}, EidAlFitr},
+		{civilDate{2024, 6, 17}, EidAlAdha},
+		{civilDate{2024, 6, 18}, EidAlAdha},
+		{civilDate{2025, 3, 31}, EidAlFitr},
+		{civilDate{2025, 6, 7}, EidAlAdha},
+		{civilDate{2025, 6, 8}, EidAlAdha},
+	},
+	Tibet: {
+		{civilDate{2023, 2, 21}, TibetanNewYear},
+		{civilDate{2023, 2, 22}, TibetanNewYear},
+		{civilDate{2023, 2, 23}, TibetanNewYear},
+		{civilDate{2024, 2, 10}, TibetanNewYear},
+		{civilDate{2024, 2, 11}, TibetanNewYear},
+		{civilDate{2024, 2, 12}, TibetanNewYear},
+		{civilDate{2025, 2, 28}, TibetanNewYear},
+		{civilDate{2025, 3, 1}, TibetanNewYear},
+		{civilDate{2025, 3, 2}, TibetanNewYear},
+	},
+	Guangxi: {
+		{civilDate{2023, 4, 22}, SanyuesanFestival},
+		{civilDate{2023, 4, 23}, SanyuesanFestival},
+		{civilDate{2024, 4, 11}, SanyuesanFestival},
+		{civilDate{2024, 4, 12}, SanyuesanFestival},
+		{civilDate{2025, 3, 31}, SanyuesanFestival},
+		{civilDate{2025, 4, 1}, SanyuesanFestival},
+	},
+}
@@ -0,0 +1,88 @@
+package chinesecalendar
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvinceCalendar(t *testing.T) {
+	c, err := ProvinceCalendar(Xinjiang)
+	assert.NoError(t, err)
+	assert.Equal(t, "新疆", Xinjiang.String())
+	start, end := c.SupportedRange()
+	assert.Equal(t, time.Date(2023, 1, 1, 0, 0, 0, 0, ChinaStandardTime), start)
+	assert.Equal(t, time.Date(2024, 10, 12, 0, 0, 0, 0, ChinaStandardTime), end)
+
+	for _, d := range dates(2024, [2]int{4, 10}, [2]int{6, 17}, [2]int{6, 18}) {
+		assert.Equal(t, false, c.IsWorkday(d))
+		assert.Equal(t, true, IsWorkday(d))
+		dayType, _ := c.GetDayType(d)
+		assert.Equal(t, StatutoryHoliday, dayType)
+	}
+	h, ok := c.GetHolidayDetail(time.Date(2024, 6, 17, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, EidAlAdha, h)
+	assert.Equal(t, ProvincialScope, h.Scope())
+	assert.Equal(t, NationalScope, NationalDay.Scope())
+	assert.Equal(t, "provincial", ProvincialScope.String())
+
+	// 地方节日没有数据的年份不在日期范围内，例如 2015 年的肉孜节
+	_, err = c.GetDayType(time.Date(2015, 7, 17, 0, 0, 0, 0, ChinaStandardTime))
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+	_, ok = c.GetHolidayDetail(time.Date(2015, 7, 17, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, false, ok)
+	_, err = c.GetDayType(time.Date(2022, 12, 31, 0, 0, 0, 0, ChinaStandardTime))
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+
+	_, err = ProvinceCalendar(Province(9))
+	assert.ErrorIs(t, err, ErrUnknownProvince)
+	assert.Equal(t, "未知", Province(9).String())
+}
+
+func TestWithProvince(t *testing.T) {
+	// 藏历新年与春节同日时以全国的安排为准
+	c, err := Default().WithProvince(Tibet)
+	assert.NoError(t, err)
+	h, _ := c.GetHolidayDetail(time.Date(2024, 2, 10, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, SpringFestival, h)
+	h, _ = c.GetHolidayDetail(time.Date(2023, 2, 21, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, TibetanNewYear, h)
+	count, err := c.CountWorkdays(time.Date(2023, 1, 1, 0, 0, 0, 0, ChinaStandardTime), time.Date(2023, 12, 31, 0, 0, 0, 0, ChinaStandardTime))
+	assert.NoError(t, err)
+	national, _ := CountWorkdays(time.Date(2023, 1, 1, 0, 0, 0, 0, ChinaStandardTime), time.Date(2023, 12, 31, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, national-3, count)
+
+	// 2023 年三月三的第二天是劳动节的调休上班日，当天仍然上班，三月三不顺延
+	c, err = Default().WithProvince(Guangxi)
+	assert.NoError(t, err)
+	assert.Equal(t, true, c.IsHoliday(time.Date(2023, 4, 22, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.Equal(t, true, c.IsWorkday(time.Date(2023, 4, 23, 0, 0, 0, 0, ChinaStandardTime)))
+	dayType, err := c.GetDayType(time.Date(2023, 4, 23, 0, 0, 0, 0, ChinaStandardTime))
+	assert.NoError(t, err)
+	assert.Equal(t, AdjustedWorkday, dayType)
+	assert.Equal(t, true, c.IsWorkday(time.Date(2023, 4, 24, 0, 0, 0, 0, ChinaStandardTime)))
+
+	// 日期范围与地方节日的数据没有重叠
+	other, err := LoadJSONFile("testdata/2030.json")
+	assert.NoError(t, err)
+	_, err = other.WithProvince(Guangxi)
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+
+	// 超出日期范围的地方节日被忽略，JSON 保留节日的适用范围
+	projected, err := ProjectCalendar(2025, 2025)
+	assert.NoError(t, err)
+	c, err = projected.WithProvince(Guangxi)
+	assert.NoError(t, err)
+	assert.Equal(t, true, c.Projected())
+	assert.Equal(t, true, c.IsHoliday(time.Date(2025, 4, 1, 0, 0, 0, 0, ChinaStandardTime)))
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, c.WriteJSON(buffer))
+	assert.Contains(t, buffer.String(), `"scope":"provincial"`)
+	loaded, err := LoadJSON(buffer)
+	assert.NoError(t, err)
+	h, _ = loaded.GetHolidayDetail(time.Date(2025, 3, 31, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, SanyuesanFestival, h)
+}
@@ -0,0 +1,69 @@
+package chinesecalendar
+
+import "fmt"
+
+// Region 地区，不同地区的公众假期不同
+type Region int
+
+const (
+	// Mainland 中国大陆，使用国务院公布的放假安排（见 Default）
+	Mainland Region = iota
+	// HongKong 香港，公众假期（General Holidays）
+	HongKong
+	// Macao 澳门，公众假日
+	Macao
+	// Taiwan 台湾，行政机关放假日，包括弹性放假与补班
+	Taiwan
+)
+
+var regionNames = [...]string{"中国大陆", "香港", "澳门", "台湾"}
+
+// regionFeeds 各地区日历的标识，见 Calendar.WriteICS
+var regionFeeds = [...]string{"mainland", "hongkong", "macao", "taiwan"}
+
+func (r Region) String() string {
+	if r < 0 || int(r) >= len(regionNames) {
+		return "未知"
+	}
+	return regionNames[r]
+}
+
+// 香港、澳门、台湾的节日定义，与大陆相同的节日（元旦、春节、清明、劳动节、端午、中秋、国庆节）使用大陆的定义
+var (
+	GoodFriday               = Holiday{"Good Friday", "耶稣受难节", 0, NationalScope}
+	EasterSaturday           = Holiday{"The day following Good Friday", "耶稣受难节翌日", 0, NationalScope}
+	EasterMonday             = Holiday{"Easter Monday", "复活节星期一", 0, NationalScope}
+	BuddhasBirthday          = Holiday{"Buddha's Birthday", "佛诞", 0, NationalScope}
+	HKSAREstablishmentDay    = Holiday{"HKSAR Establishment Day", "香港特别行政区成立纪念日", 0, NationalScope}
+	MidAutumnNextDay         = Holiday{"The day following Mid-autumn Festival", "中秋节翌日", 0, NationalScope}
+	ChungYeungFestival       = Holiday{"Chung Yeung Festival", "重阳节", 0, NationalScope}
+	ChristmasEve             = Holiday{"Christmas Eve", "圣诞节前夕", 0, NationalScope}
+	ChristmasDay             = Holiday{"Christmas Day", "圣诞节", 0, NationalScope}
+	BoxingDay                = Holiday{"The first weekday after Christmas Day", "圣诞节后第一个周日", 0, NationalScope}
+	AllSoulsDay              = Holiday{"All Souls' Day", "追思节", 0, NationalScope}
+	ImmaculateConception     = Holiday{"Feast of the Immaculate Conception", "圣母无原罪瞻礼", 0, NationalScope}
+	WinterSolsticeFestival   = Holiday{"Winter Solstice", "冬至", 0, NationalScope}
+	MacaoSAREstablishmentDay = Holiday{"Macao SAR Establishment Day", "澳门特别行政区成立纪念日", 0, NationalScope}
+	PeaceMemorialDay         = Holiday{"Peace Memorial Day", "和平纪念日", 0, NationalScope}
+	ChildrensDay             = Holiday{"Children's Day", "儿童节", 0, NationalScope}
+	ConfuciusBirthday        = Holiday{"Confucius' Birthday", "孔子诞辰纪念日", 0, NationalScope}
+	RetrocessionDay          = Holiday{"Retrocession Day", "台湾光复暨金门古宁头大捷纪念日", 0, NationalScope}
+	ConstitutionDay          = Holiday{"Constitution Day", "行宪纪念日", 0, NationalScope}
+	DoubleTenthDay           = Holiday{"Double Tenth Day", "国庆日", 0, NationalScope}
+)
+
+// RegionCalendar 返回地区的日历。
+// 香港、澳门、台湾的数据由 scripts/generator 按各地的假期规则生成，覆盖范围见 Calendar.SupportedRange：
+// 公众假期标记为法定假日，因假期逢周日（台湾为周六、周日）而补放的日期标记为替代日；
+// 台湾的弹性放假与补班按行政院人事行政总处公布的安排，澳门给予公务人员的补假不包括在内。
+// region 不是已知的地区时返回 ErrUnknownRegion
+func RegionCalendar(region Region) (*Calendar, error) {
+	if region == Mainland {
+		return defaultCalendar, nil
+	}
+	c, ok := regionCalendars[region]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownRegion, int(region))
+	}
+	return c, nil
+}
@@ -0,0 +1,239 @@
+// Code generated by "scripts/generator"; DO NOT EDIT.
+
+package chinesecalendar
+
+// regionCalendars 香港、澳门、台湾的放假安排，见 RegionCalendar
+var regionCalendars = map[Region]*Calendar{
+	HongKong: {
+		feed:   regionFeeds[HongKong],
+		minDay: civilDate{2023, 1, 1},
+		maxDay: civilDate{2025, 12, 31},
+		tables: []yearTable{
+			{
+				year:       2023,
+				holidays:   dayBits{0x0000000001e00003, 0x0100000b40000000, 0x0020100000020000, 0x0000000000000000, 0x0000008000070000, 0x000000c000000000},
+				workdays:   dayBits{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000},
+				inLieuDays: dayBits{0x0000000001000002, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000040000, 0x0000000000000000},
+				statutory:  dayBits{0x0000000000e00001, 0x0100000b40000000, 0x0020100000020000, 0x0000000000000000, 0x0000008000030000, 0x000000c000000000},
+				tags: []dayTag{
+					{0, 1, NewYearsDay},
+					{21, 24, SpringFestival},
+					{94, 94, TombSweepingDay},
+					{96, 96, GoodFriday},
+					{97, 97, EasterSaturday},
+					{99, 99, EasterMonday},
+					{120, 120, LabourDay},
+					{145, 145, BuddhasBirthday},
+					{172, 172, DragonBoatFestival},
+					{181, 181, HKSAREstablishmentDay},
+					{272, 272, MidAutumnNextDay},
+					{273, 274, NationalDay},
+					{295, 295, ChungYeungFestival},
+					{358, 358, ChristmasDay},
+					{359, 359, BoxingDay},
+				},
+			},
+			{
+				year:       2024,
+				holidays:   dayBits{0x00000f0000000001, 0x020000004b000000, 0x0040000200000080, 0x0000000000000000, 0x0000000010040020, 0x0000018000000000},
+				workdays:   dayBits{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000},
+				inLieuDays: dayBits{0x0000080000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000},
+				statutory:  dayBits{0x0000070000000001, 0x020000004b000000, 0x0040000200000080, 0x0000000000000000, 0x0000000010040020, 0x0000018000000000},
+				tags: []dayTag{
+					{0, 0, NewYearsDay},
+					{40, 43, SpringFestival},
+					{88, 88, GoodFriday},
+					{89, 89, EasterSaturday},
+					{91, 91, EasterMonday},
+					{94, 94, TombSweepingDay},
+					{121, 121, LabourDay},
+					{135, 135, BuddhasBirthday},
+					{161, 161, DragonBoatFestival},
+					{182, 182, HKSAREstablishmentDay},
+					{261, 261, MidAutumnNextDay},
+					{274, 274, NationalDay},
+					{284, 284, ChungYeungFestival},
+					{359, 359, ChristmasDay},
+					{360, 360, BoxingDay},
+				},
+			},
+			{
+				year:       2025,
+				holidays:   dayBits{0x0000000070000001, 0x1100580020000000, 0x0020000000400000, 0x0000000000000000, 0x0000200000820000, 0x000000c000000000},
+				workdays:   dayBits{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000},
+				inLieuDays: dayBits{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000},
+				statutory:  dayBits{0x0000000070000001, 0x1100580020000000, 0x0020000000400000, 0x0000000000000000, 0x0000200000820000, 0x000000c000000000},
+				tags: []dayTag{
+					{0, 0, NewYearsDay},
+					{28, 30, SpringFestival},
+					{93, 93, TombSweepingDay},
+					{107, 107, GoodFriday},
+					{108, 108, EasterSaturday},
+					{110, 110, EasterMonday},
+					{120, 120, LabourDay},
+					{124, 124, BuddhasBirthday},
+					{150, 150, DragonBoatFestival},
+					{181, 181, HKSAREstablishmentDay},
+					{273, 273, NationalDay},
+					{279, 279, MidAutumnNextDay},
+					{301, 301, ChungYeungFestival},
+					{358, 358, ChristmasDay},
+					{359, 359, BoxingDay},
+				},
+			},
+		},
+	},
+	Macao: {
+		feed:   regionFeeds[Macao],
+		minDay: civilDate{2023, 1, 1},
+		maxDay: civilDate{2025, 12, 31},
+		tables: []yearTable{
+			{
+				year:       2023,
+				holidays:   dayBits{0x0000000000e00001, 0x0100000340000000, 0x0000100000020000, 0x0000000000000000, 0x0002008000070000, 0x0000006a00200000},
+				workdays:   dayBits{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000},
+				inLieuDays: dayBits{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000},
+				statutory:  dayBits{0x0000000000e00001, 0x0100000340000000, 0x0000100000020000, 0x0000000000000000, 0x0002008000070000, 0x0000006a00200000},
+				tags: []dayTag{
+					{0, 0, NewYearsDay},
+					{21, 23, SpringFestival},
+					{94, 94, TombSweepingDay},
+					{96, 96, GoodFriday},
+					{97, 97, EasterSaturday},
+					{120, 120, LabourDay},
+					{145, 145, BuddhasBirthday},
+					{172, 172, DragonBoatFestival},
+					{272, 272, MidAutumnNextDay},
+					{273, 274, NationalDay},
+					{295, 295, ChungYeungFestival},
+					{305, 305, AllSoulsDay},
+					{341, 341, ImmaculateConception},
+					{353, 353, MacaoSAREstablishmentDay},
+					{355, 355, WinterSolsticeFestival},
+					{357, 357, ChristmasEve},
+					{358, 358, ChristmasDay},
+				},
+			},
+			{
+				year:       2024,
+				holidays:   dayBits{0x0000070000000001, 0x0200000043000000, 0x0000000200000080, 0x0000000000000000, 0x00040000100c0020, 0x000000cc00400000},
+				workdays:   dayBits{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000},
+				inLieuDays: dayBits{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000},
+				statutory:  dayBits{0x0000070000000001, 0x0200000043000000, 0x0000000200000080, 0x0000000000000000, 0x00040000100c0020, 0x000000cc00400000},
+				tags: []dayTag{
+					{0, 0, NewYearsDay},
+					{40, 42, SpringFestival},
+					{88, 88, GoodFriday},
+					{89, 89, EasterSaturday},
+					{94, 94, TombSweepingDay},
+					{121, 121, LabourDay},
+					{135, 135, BuddhasBirthday},
+					{161, 161, DragonBoatFestival},
+					{261, 261, MidAutumnNextDay},
+					{274, 275, NationalDay},
+					{284, 284, ChungYeungFestival},
+					{306, 306, AllSoulsDay},
+					{342, 342, ImmaculateConception},
+					{354, 354, MacaoSAREstablishmentDay},
+					{355, 355, WinterSolsticeFestival},
+					{358, 358, ChristmasEve},
+					{359, 359, ChristmasDay},
+				},
+			},
+			{
+				year:       2025,
+				holidays:   dayBits{0x0000000070000001, 0x1100180020000000, 0x0000000000400000, 0x0000000000000000, 0x0002200000860000, 0x0000006600200000},
+				workdays:   dayBits{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000},
+				inLieuDays: dayBits{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000},
+				statutory:  dayBits{0x0000000070000001, 0x1100180020000000, 0x0000000000400000, 0x0000000000000000, 0x0002200000860000, 0x0000006600200000},
+				tags: []dayTag{
+					{0, 0, NewYearsDay},
+					{28, 30, SpringFestival},
+					{93, 93, TombSweepingDay},
+					{107, 107, GoodFriday},
+					{108, 108, EasterSaturday},
+					{120, 120, LabourDay},
+					{124, 124, BuddhasBirthday},
+					{150, 150, DragonBoatFestival},
+					{273, 274, NationalDay},
+					{279, 279, MidAutumnNextDay},
+					{301, 301, ChungYeungFestival},
+					{305, 305, AllSoulsDay},
+					{341, 341, ImmaculateConception},
+					{353, 353, MacaoSAREstablishmentDay},
+					{354, 354, WinterSolsticeFestival},
+					{357, 357, ChristmasEve},
+					{358, 358, ChristmasDay},
+				},
+			},
+		},
+	},
+	Taiwan: {
+		feed:   regionFeeds[Taiwan],
+		minDay: civilDate{2023, 1, 1},
+		maxDay: civilDate{2025, 12, 31},
+		tables: []yearTable{
+			{
+				year:       2023,
+				holidays:   dayBits{0x0600000007f80003, 0x0000000070000000, 0x0000300000000000, 0x0000000000000000, 0x0000000006008000, 0x0000000000000000},
+				workdays:   dayBits{0x0001000400000040, 0x0000000000080000, 0x0000008000000000, 0x0000000000000000, 0x0000000000000200, 0x0000000000000000},
+				inLieuDays: dayBits{0x0200000007080002, 0x0000000010000000, 0x0000200000000000, 0x0000000000000000, 0x0000000002000000, 0x0000000000000000},
+				statutory:  dayBits{0x0400000000f00001, 0x0000000060000000, 0x0000100000000000, 0x0000000000000000, 0x0000000004008000, 0x0000000000000000},
+				tags: []dayTag{
+					{0, 1, NewYearsDay},
+					{6, 6, SpringFestival},
+					{19, 26, SpringFestival},
+					{34, 34, SpringFestival},
+					{48, 48, PeaceMemorialDay},
+					{57, 58, PeaceMemorialDay},
+					{83, 83, ChildrensDay},
+					{92, 93, ChildrensDay},
+					{94, 94, TombSweepingDay},
+					{167, 167, DragonBoatFestival},
+					{172, 173, DragonBoatFestival},
+					{265, 265, DoubleTenthDay},
+					{271, 271, MidAutumnFestival},
+					{281, 282, DoubleTenthDay},
+				},
+			},
+			{
+				year:       2024,
+				holidays:   dayBits{0x04001fc000000001, 0x00000000c0000000, 0x0000000200000000, 0x0000000000000000, 0x0000000008000010, 0x0000000000000000},
+				workdays:   dayBits{0x0000800000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000},
+				inLieuDays: dayBits{0x0000184000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000},
+				statutory:  dayBits{0x0400078000000001, 0x00000000c0000000, 0x0000000200000000, 0x0000000000000000, 0x0000000008000010, 0x0000000000000000},
+				tags: []dayTag{
+					{0, 0, NewYearsDay},
+					{38, 44, SpringFestival},
+					{47, 47, SpringFestival},
+					{58, 58, PeaceMemorialDay},
+					{94, 94, TombSweepingDay},
+					{95, 95, ChildrensDay},
+					{161, 161, DragonBoatFestival},
+					{260, 260, MidAutumnFestival},
+					{283, 283, DoubleTenthDay},
+				},
+			},
+			{
+				year:       2025,
+				holidays:   dayBits{0x040000007c000001, 0x0000000030000000, 0x0000000000600000, 0x0000000000000000, 0x000003000440c000, 0x0000004000000000},
+				workdays:   dayBits{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000},
+				inLieuDays: dayBits{0x0000000000000000, 0x0000000000000000, 0x0000000000200000, 0x0000000000000000, 0x0000010000008000, 0x0000000000000000},
+				statutory:  dayBits{0x040000007c000001, 0x0000000030000000, 0x0000000000400000, 0x0000000000000000, 0x0000020004404000, 0x0000004000000000},
+				tags: []dayTag{
+					{0, 0, NewYearsDay},
+					{26, 30, SpringFestival},
+					{58, 58, PeaceMemorialDay},
+					{92, 92, ChildrensDay},
+					{93, 93, TombSweepingDay},
+					{149, 150, DragonBoatFestival},
+					{270, 271, ConfuciusBirthday},
+					{278, 278, MidAutumnFestival},
+					{282, 282, DoubleTenthDay},
+					{296, 297, RetrocessionDay},
+					{358, 358, ConstitutionDay},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,108 @@
+package chinesecalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func dates(year int, monthDays ...[2]int) []time.Time {
+	list := make([]time.Time, 0, len(monthDays))
+	for _, md := range monthDays {
+		list = append(list, time.Date(year, time.Month(md[0]), md[1], 0, 0, 0, 0, ChinaStandardTime))
+	}
+	return list
+}
+
+func TestRegionCalendar(t *testing.T) {
+	c, err := RegionCalendar(Mainland)
+	assert.NoError(t, err)
+	assert.Equal(t, Default(), c)
+
+	_, err = RegionCalendar(Region(9))
+	assert.ErrorIs(t, err, ErrUnknownRegion)
+	assert.Equal(t, "未知", Region(9).String())
+	assert.Equal(t, "香港", HongKong.String())
+
+	for _, region := range []Region{HongKong, Macao, Taiwan} {
+		c, err := RegionCalendar(region)
+		assert.NoError(t, err)
+		start, end := c.SupportedRange()
+		assert.Equal(t, time.Date(2023, 1, 1, 0, 0, 0, 0, ChinaStandardTime), start)
+		assert.Equal(t, time.Date(2025, 12, 31, 0, 0, 0, 0, ChinaStandardTime), end)
+	}
+}
+
+func TestHongKong(t *testing.T) {
+	c, _ := RegionCalendar(HongKong)
+
+	holidays, err := c.GetHolidays(time.Date(2024, 1, 1, 0, 0, 0, 0, ChinaStandardTime), time.Date(2024, 12, 31, 0, 0, 0, 0, ChinaStandardTime), false)
+	assert.NoError(t, err)
+	assert.Equal(t, dates(2024, [2]int{1, 1}, [2]int{2, 10}, [2]int{2, 11}, [2]int{2, 12}, [2]int{2, 13},
+		[2]int{3, 29}, [2]int{3, 30}, [2]int{4, 1}, [2]int{4, 4}, [2]int{5, 1}, [2]int{5, 15}, [2]int{6, 10},
+		[2]int{7, 1}, [2]int{9, 18}, [2]int{10, 1}, [2]int{10, 11}, [2]int{12, 25}, [2]int{12, 26}), holidays)
+
+	// 年初二逢星期日，年初四补假
+	assert.Equal(t, true, c.IsInLieu(time.Date(2024, 2, 13, 0, 0, 0, 0, ChinaStandardTime)))
+	h, _ := c.GetHolidayDetail(time.Date(2024, 2, 13, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, SpringFestival, h)
+	// 国庆节逢星期日，翌日补假
+	assert.Equal(t, true, c.IsInLieu(time.Date(2023, 10, 2, 0, 0, 0, 0, ChinaStandardTime)))
+	dayType, _ := c.GetDayType(time.Date(2025, 4, 18, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, StatutoryHoliday, dayType)
+	h, _ = c.GetHolidayDetail(time.Date(2025, 4, 18, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, GoodFriday, h)
+	assert.Equal(t, true, c.IsWorkday(time.Date(2025, 10, 6, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.Equal(t, false, c.IsWorkday(time.Date(2025, 10, 7, 0, 0, 0, 0, ChinaStandardTime)))
+}
+
+func TestMacao(t *testing.T) {
+	c, _ := RegionCalendar(Macao)
+
+	holidays, err := c.GetHolidays(time.Date(2024, 12, 1, 0, 0, 0, 0, ChinaStandardTime), time.Date(2024, 12, 31, 0, 0, 0, 0, ChinaStandardTime), false)
+	assert.NoError(t, err)
+	assert.Equal(t, dates(2024, [2]int{12, 8}, [2]int{12, 20}, [2]int{12, 21}, [2]int{12, 24}, [2]int{12, 25}), holidays)
+	h, _ := c.GetHolidayDetail(time.Date(2024, 12, 21, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, WinterSolsticeFestival, h)
+
+	// 澳门的公众假日逢周末不补假
+	count, err := c.CountHolidays(time.Date(2023, 1, 1, 0, 0, 0, 0, ChinaStandardTime), time.Date(2025, 12, 31, 0, 0, 0, 0, ChinaStandardTime), false)
+	assert.NoError(t, err)
+	inLieu := 0
+	for d := time.Date(2023, 1, 1, 0, 0, 0, 0, ChinaStandardTime); d.Year() <= 2025; d = d.AddDate(0, 0, 1) {
+		if c.IsInLieu(d) {
+			inLieu++
+		}
+	}
+	assert.Equal(t, 0, inLieu)
+	assert.Equal(t, 3*20, count)
+}
+
+func TestTaiwan(t *testing.T) {
+	c, _ := RegionCalendar(Taiwan)
+
+	holidays, err := c.GetHolidays(time.Date(2024, 1, 1, 0, 0, 0, 0, ChinaStandardTime), time.Date(2024, 12, 31, 0, 0, 0, 0, ChinaStandardTime), false)
+	assert.NoError(t, err)
+	assert.Equal(t, dates(2024, [2]int{1, 1}, [2]int{2, 8}, [2]int{2, 9}, [2]int{2, 10}, [2]int{2, 11}, [2]int{2, 12},
+		[2]int{2, 13}, [2]int{2, 14}, [2]int{2, 28}, [2]int{4, 4}, [2]int{4, 5}, [2]int{6, 10}, [2]int{9, 17},
+		[2]int{10, 10}), holidays)
+	// 弹性放假与补班
+	assert.Equal(t, true, c.IsInLieu(time.Date(2024, 2, 8, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.Equal(t, true, c.IsWorkday(time.Date(2024, 2, 17, 0, 0, 0, 0, ChinaStandardTime)))
+	// 儿童节与清明同日且为星期四，儿童节于后一日放假
+	h, _ := c.GetHolidayDetail(time.Date(2024, 4, 5, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, ChildrensDay, h)
+
+	holidays, err = c.GetHolidays(time.Date(2025, 1, 1, 0, 0, 0, 0, ChinaStandardTime), time.Date(2025, 12, 31, 0, 0, 0, 0, ChinaStandardTime), false)
+	assert.NoError(t, err)
+	assert.Equal(t, dates(2025, [2]int{1, 1}, [2]int{1, 27}, [2]int{1, 28}, [2]int{1, 29}, [2]int{1, 30}, [2]int{1, 31},
+		[2]int{2, 28}, [2]int{4, 3}, [2]int{4, 4}, [2]int{5, 30}, [2]int{5, 31}, [2]int{9, 28}, [2]int{9, 29},
+		[2]int{10, 6}, [2]int{10, 10}, [2]int{10, 24}, [2]int{10, 25}, [2]int{12, 25}), holidays)
+	// 端午逢星期六，于前一个上班日补假；教师节逢星期日，于次一个上班日补假
+	assert.Equal(t, true, c.IsInLieu(time.Date(2025, 5, 30, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.Equal(t, true, c.IsInLieu(time.Date(2025, 9, 29, 0, 0, 0, 0, ChinaStandardTime)))
+	workdays, err := c.CountWorkdays(time.Date(2025, 1, 1, 0, 0, 0, 0, ChinaStandardTime), time.Date(2025, 12, 31, 0, 0, 0, 0, ChinaStandardTime))
+	assert.NoError(t, err)
+	assert.Equal(t, 261-15, workdays)
+}
@@ -2,6 +2,9 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
 	"os"
 	"os/exec"
 	"reflect"
@@ -12,6 +15,7 @@ import (
 
 	"github.com/wangzeping722/chinesecalendar"
 	. "github.com/wangzeping722/chinesecalendar/internal"
+	"github.com/wangzeping722/chinesecalendar/internal/astro"
 )
 
 const dateTypeWorkday = 1
@@ -36,12 +40,14 @@ type arrangement struct {
 	Holidays        map[time.Time]chinesecalendar.Holiday
 	Workdays        map[time.Time]chinesecalendar.Holiday
 	InLieuDays      map[time.Time]chinesecalendar.Holiday
+	Statutory       map[time.Time]bool
 	HolidayList     timeList
 	WorkdayList     timeList
 	InLieuDayList   timeList
 	HolidayFieldMap map[chinesecalendar.Holiday]string
 	MaxDay          time.Time
 	MinDay          time.Time
+	YearTables      []yearTable
 
 	year    int
 	month   int
@@ -50,11 +56,28 @@ type arrangement struct {
 	dayType int
 }
 
+// yearTable 一年的位图数据，第 n 位对应该年第 n+1 天
+type yearTable struct {
+	Year       int
+	Holidays   [6]uint64
+	Workdays   [6]uint64
+	InLieuDays [6]uint64
+	Statutory  [6]uint64
+	Tags       []dayTag
+}
+
+// dayTag 年内 [First, Last] 这段被标记的日期所属的节日
+type dayTag struct {
+	First, Last int
+	Holiday     string
+}
+
 func newArragement() *arrangement {
 	return &arrangement{
 		Holidays:   make(map[time.Time]chinesecalendar.Holiday),
 		Workdays:   make(map[time.Time]chinesecalendar.Holiday),
 		InLieuDays: make(map[time.Time]chinesecalendar.Holiday),
+		Statutory:  make(map[time.Time]bool),
 		HolidayFieldMap: map[chinesecalendar.Holiday]string{
 			chinesecalendar.NewYearsDay:        "NewYearsDay",
 			chinesecalendar.SpringFestival:     "SpringFestival",
@@ -63,6 +86,33 @@ func newArragement() *arrangement {
 			chinesecalendar.DragonBoatFestival: "DragonBoatFestival",
 			chinesecalendar.NationalDay:        "NationalDay",
 			chinesecalendar.MidAutumnFestival:  "MidAutumnFestival",
+			chinesecalendar.AntiFascist70thDay: "AntiFascist70thDay",
+
+			chinesecalendar.GoodFriday:               "GoodFriday",
+			chinesecalendar.EasterSaturday:           "EasterSaturday",
+			chinesecalendar.EasterMonday:             "EasterMonday",
+			chinesecalendar.BuddhasBirthday:          "BuddhasBirthday",
+			chinesecalendar.HKSAREstablishmentDay:    "HKSAREstablishmentDay",
+			chinesecalendar.MidAutumnNextDay:         "MidAutumnNextDay",
+			chinesecalendar.ChungYeungFestival:       "ChungYeungFestival",
+			chinesecalendar.ChristmasEve:             "ChristmasEve",
+			chinesecalendar.ChristmasDay:             "ChristmasDay",
+			chinesecalendar.BoxingDay:                "BoxingDay",
+			chinesecalendar.AllSoulsDay:              "AllSoulsDay",
+			chinesecalendar.ImmaculateConception:     "ImmaculateConception",
+			chinesecalendar.WinterSolsticeFestival:   "WinterSolsticeFestival",
+			chinesecalendar.MacaoSAREstablishmentDay: "MacaoSAREstablishmentDay",
+			chinesecalendar.PeaceMemorialDay:         "PeaceMemorialDay",
+			chinesecalendar.ChildrensDay:             "ChildrensDay",
+			chinesecalendar.ConfuciusBirthday:        "ConfuciusBirthday",
+			chinesecalendar.RetrocessionDay:          "RetrocessionDay",
+			chinesecalendar.ConstitutionDay:          "ConstitutionDay",
+			chinesecalendar.DoubleTenthDay:           "DoubleTenthDay",
+
+			chinesecalendar.EidAlFitr:         "EidAlFitr",
+			chinesecalendar.EidAlAdha:         "EidAlAdha",
+			chinesecalendar.TibetanNewYear:    "TibetanNewYear",
+			chinesecalendar.SanyuesanFestival: "SanyuesanFestival",
 		},
 		MaxDay: time.Time{},
 		MinDay: Date(2099, 1, 1),
@@ -95,6 +145,121 @@ func (ag *arrangement) generateHolidays() {
 	sort.Sort(ag.HolidayList)
 	sort.Sort(ag.WorkdayList)
 	sort.Sort(ag.InLieuDayList)
+	ag.generateStatutoryDays()
+	ag.generateYearTables()
+}
+
+// festivalDates 春节（正月初一）、清明、端午（五月初五）、中秋（八月十五）的公历日期
+var festivalDates = map[int][4]time.Time{
+	2004: {Date(2004, 1, 22)},
+	2005: {Date(2005, 2, 9)},
+	2006: {Date(2006, 1, 29)},
+	2007: {Date(2007, 2, 18)},
+	2008: {Date(2008, 2, 7), Date(2008, 4, 4), Date(2008, 6, 8), Date(2008, 9, 14)},
+	2009: {Date(2009, 1, 26), Date(2009, 4, 4), Date(2009, 5, 28), Date(2009, 10, 3)},
+	2010: {Date(2010, 2, 14), Date(2010, 4, 5), Date(2010, 6, 16), Date(2010, 9, 22)},
+	2011: {Date(2011, 2, 3), Date(2011, 4, 5), Date(2011, 6, 6), Date(2011, 9, 12)},
+	2012: {Date(2012, 1, 23), Date(2012, 4, 4), Date(2012, 6, 23), Date(2012, 9, 30)},
+	2013: {Date(2013, 2, 10), Date(2013, 4, 4), Date(2013, 6, 12), Date(2013, 9, 19)},
+	2014: {Date(2014, 1, 31), Date(2014, 4, 5), Date(2014, 6, 2), Date(2014, 9, 8)},
+	2015: {Date(2015, 2, 19), Date(2015, 4, 5), Date(2015, 6, 20), Date(2015, 9, 27)},
+	2016: {Date(2016, 2, 8), Date(2016, 4, 4), Date(2016, 6, 9), Date(2016, 9, 15)},
+	2017: {Date(2017, 1, 28), Date(2017, 4, 4), Date(2017, 5, 30), Date(2017, 10, 4)},
+	2018: {Date(2018, 2, 16), Date(2018, 4, 5), Date(2018, 6, 18), Date(2018, 9, 24)},
+	2019: {Date(2019, 2, 5), Date(2019, 4, 5), Date(2019, 6, 7), Date(2019, 9, 13)},
+	2020: {Date(2020, 1, 25), Date(2020, 4, 4), Date(2020, 6, 25), Date(2020, 10, 1)},
+	2021: {Date(2021, 2, 12), Date(2021, 4, 4), Date(2021, 6, 14), Date(2021, 9, 21)},
+	2022: {Date(2022, 2, 1), Date(2022, 4, 5), Date(2022, 6, 3), Date(2022, 9, 10)},
+	2023: {Date(2023, 1, 22), Date(2023, 4, 5), Date(2023, 6, 22), Date(2023, 9, 29)},
+	2024: {Date(2024, 2, 10), Date(2024, 4, 4), Date(2024, 6, 10), Date(2024, 9, 17)},
+}
+
+// generateStatutoryDays 按《全国年节及纪念日放假办法》标记法定假日：
+// 元旦 1 月 1 日；春节 2008 年至 2013 年为除夕至初二，其余年份为初一至初三；
+// 劳动节 2008 年以前为 5 月 1 日至 3 日，之后为 5 月 1 日；
+// 清明、端午、中秋自 2008 年起各 1 天；国庆节 10 月 1 日至 3 日
+func (ag *arrangement) generateStatutoryDays() {
+	for year := ag.MinDay.Year(); year <= ag.MaxDay.Year(); year++ {
+		dates, ok := festivalDates[year]
+		if !ok {
+			panic(fmt.Sprintf("festival dates of %d are missing", year))
+		}
+		springFestival, tombSweepingDay, dragonBoatFestival, midAutumnFestival := dates[0], dates[1], dates[2], dates[3]
+
+		ag.statutory(Date(year, 1, 1), 1)
+		if year >= 2008 && year <= 2013 {
+			ag.statutory(springFestival.AddDate(0, 0, -1), 3)
+		} else {
+			ag.statutory(springFestival, 3)
+		}
+		if year < 2008 {
+			ag.statutory(Date(year, 5, 1), 3)
+		} else {
+			ag.statutory(Date(year, 5, 1), 1)
+			ag.statutory(tombSweepingDay, 1)
+			ag.statutory(dragonBoatFestival, 1)
+			ag.statutory(midAutumnFestival, 1)
+		}
+		ag.statutory(Date(year, 10, 1), 3)
+		if year == 2015 {
+			ag.statutory(Date(2015, 9, 3), 1)
+		}
+	}
+}
+
+// statutory 标记从 start 开始连续 days 天为法定假日，这些日期必须在放假安排中
+func (ag *arrangement) statutory(start time.Time, days int) {
+	for i := 0; i < days; i++ {
+		t := start.AddDate(0, 0, i)
+		if _, ok := ag.Holidays[t]; !ok {
+			panic(fmt.Sprintf("statutory day %s is not a holiday", t.Format("2006-01-02")))
+		}
+		ag.Statutory[t] = true
+	}
+}
+
+func (ag *arrangement) generateYearTables() {
+	for year := ag.MinDay.Year(); year <= ag.MaxDay.Year(); year++ {
+		tb := yearTable{Year: year}
+		for t := Date(year, 1, 1); t.Year() == year; t = t.AddDate(0, 0, 1) {
+			i := t.YearDay() - 1
+			holiday, isHoliday := ag.Holidays[t]
+			workday, isWorkday := ag.Workdays[t]
+			inLieu, isInLieu := ag.InLieuDays[t]
+			if isHoliday && isWorkday {
+				panic(fmt.Sprintf("%s is both holiday and workday", t.Format("2006-01-02")))
+			}
+			if isInLieu && (!isHoliday || inLieu != holiday) {
+				panic(fmt.Sprintf("in lieu day %s should be a holiday of %s", t.Format("2006-01-02"), inLieu.Name()))
+			}
+
+			var tag chinesecalendar.Holiday
+			switch {
+			case isHoliday:
+				tb.Holidays[i/64] |= 1 << uint(i%64)
+				tag = holiday
+			case isWorkday:
+				tb.Workdays[i/64] |= 1 << uint(i%64)
+				tag = workday
+			default:
+				continue
+			}
+			if isInLieu {
+				tb.InLieuDays[i/64] |= 1 << uint(i%64)
+			}
+			if ag.Statutory[t] {
+				tb.Statutory[i/64] |= 1 << uint(i%64)
+			}
+
+			field := ag.HolidayFieldMap[tag]
+			if n := len(tb.Tags); n > 0 && tb.Tags[n-1].Last == i-1 && tb.Tags[n-1].Holiday == field {
+				tb.Tags[n-1].Last = i
+			} else {
+				tb.Tags = append(tb.Tags, dayTag{First: i, Last: i, Holiday: field})
+			}
+		}
+		ag.YearTables = append(ag.YearTables, tb)
+	}
 }
 
 func (ag *arrangement) Y2024() {
@@ -263,7 +428,7 @@ func (ag *arrangement) Y2016() {
 	// 五、端午节：6月9日至11日放假调休，共3天。6月12日（星期日）上班。
 	// 六、中秋节：9月15日至17日放假调休，共3天。9月18日（星期日）上班。
 	// 七、国庆节：10月1日至7日放假调休，共7天。10月8日（星期六）、10月9日（星期日）上班。
-	ag.yearAt(2017).
+	ag.yearAt(2016).
 		nyd().rest(1, 1).
 		sf().rest(2, 7).to(2, 13).work(2, 6).work(2, 14).inLieu(2, 11).to(2, 12).
 		tsd().rest(4, 4).
@@ -345,7 +510,7 @@ func (ag *arrangement) Y2012() {
 		sf().rest(1, 22).to(1, 28).work(1, 21).work(1, 29).inLieu(1, 26).to(1, 27).
 		tsd().rest(4, 2).to(4, 4).work(3, 31).work(4, 1).inLieu(4, 2).to(4, 3).
 		ld().rest(4, 29).to(5, 1).work(4, 28).inLieu(4, 30).
-		dbf().rest(6, 22).rest(6, 24).
+		dbf().rest(6, 22).to(6, 24).
 		maf().rest(9, 30).
 		nd().rest(10, 1).to(10, 7).work(9, 29).inLieu(10, 5)
 }
@@ -571,7 +736,7 @@ func (ag *arrangement) maf() *arrangement {
 
 // 中国人民抗日战争暨世界反法西斯战争胜利70周年纪念日 Anti-Fascist 70th Day
 func (ag *arrangement) afd() *arrangement {
-	return ag.mark(chinesecalendar.NationalDay)
+	return ag.mark(chinesecalendar.AntiFascist70thDay)
 }
 
 func (ag *arrangement) mark(holiday chinesecalendar.Holiday) *arrangement {
@@ -601,7 +766,7 @@ func (ag *arrangement) save(month, day, dayType int) *arrangement {
 	ag.month = month
 	ag.day = day
 	ag.dayType = dayType
-	t := time.Date(ag.year, time.Month(month), day, 0, 0, 0, 0, time.Local)
+	t := Date(ag.year, month, day)
 	switch dayType {
 	case dateTypeHoliday:
 		ag.Holidays[t] = ag.holiday
@@ -622,9 +787,7 @@ func (ag *arrangement) to(month, day int) *arrangement {
 	if endDate.Before(startDate) {
 		panic("end date should be after start date")
 	}
-	days := int(endDate.Sub(startDate)/(24*time.Hour)) + 1
-	for i := 0; i < days; i++ {
-		t := startDate.Add(24 * time.Hour * time.Duration(i))
+	for t := startDate; !t.After(endDate); t = t.AddDate(0, 0, 1) {
 		switch ag.dayType {
 		case dateTypeHoliday:
 			ag.Holidays[t] = ag.holiday
@@ -642,57 +805,721 @@ var arrangementTemplate = `// Code generated by "scripts/generator"; DO NOT EDIT
 // Code generated by "scripts/generator"; DO NOT EDIT.
 package chinesecalendar
 
-import (
-	"time"
-	. "github.com/wangzeping722/chinesecalendar/internal"
-)
-
 var (
 	// 节假日定义
-	minDay = Date({{.MinDay.Year}}, {{.MinDay.Month | printf "%d"}}, {{.MinDay.Day}})
-	maxDay = Date({{.MaxDay.Year}}, {{.MaxDay.Month | printf "%d"}}, {{.MaxDay.Day}})
-	// 节假日
-	holidays = map[time.Time]Holiday{
-		{{range $key := .HolidayList}}{{with index $.Holidays $key}}Date({{$key.Year}}, {{$key.Month | printf "%d"}}, {{$key.Day}}):{{index $.HolidayFieldMap .}},
-		{{end}}{{end}}
-	}
+	minDay = civilDate{ {{- .MinDay.Year}}, {{.MinDay.Month | printf "%d"}}, {{.MinDay.Day -}} }
+	maxDay = civilDate{ {{- .MaxDay.Year}}, {{.MaxDay.Month | printf "%d"}}, {{.MaxDay.Day -}} }
 
-	// 工作日
-	workdays = map[time.Time]Holiday{
-		{{range $key := .WorkdayList}}{{with index $.Workdays $key}}Date({{$key.Year}}, {{$key.Month | printf "%d"}}, {{$key.Day}}):{{index $.HolidayFieldMap .}},
-		{{end}}{{end}}
+	// 按年份排列的节假日位图：holidays 放假日，workdays 调休上班日，inLieuDays 替代日，statutory 法定假日
+	yearTables = []yearTable{
+		{{- range .YearTables}}
+		{
+			year:       {{.Year}},
+			holidays:   dayBits{ {{- range $i, $w := .Holidays}}{{if $i}}, {{end}}{{printf "%#016x" $w}}{{end -}} },
+			workdays:   dayBits{ {{- range $i, $w := .Workdays}}{{if $i}}, {{end}}{{printf "%#016x" $w}}{{end -}} },
+			inLieuDays: dayBits{ {{- range $i, $w := .InLieuDays}}{{if $i}}, {{end}}{{printf "%#016x" $w}}{{end -}} },
+			statutory:  dayBits{ {{- range $i, $w := .Statutory}}{{if $i}}, {{end}}{{printf "%#016x" $w}}{{end -}} },
+			tags: []dayTag{
+				{{- range .Tags}}
+				{ {{- .First}}, {{.Last}}, {{.Holiday -}} },
+				{{- end}}
+			},
+		},
+		{{- end}}
 	}
+)
+`
 
-	// 替代日
-	inLieuDays = map[time.Time]Holiday{
-		{{range $key := .InLieuDayList}}{{with index $.InLieuDays $key}}Date({{$key.Year}}, {{$key.Month | printf "%d"}}, {{$key.Day}}):{{index $.HolidayFieldMap .}},
-		{{end}}{{end}}
-	}
+var lunarTemplate = `// Code generated by "scripts/generator"; DO NOT EDIT.
+
+package chinesecalendar
+
+// lunarYears 农历 {{(index . 0).Year}} 年至 {{(index . (len . | add -1)).Year}} 年每年的数据，见 lunarYear
+var lunarYears = [...]lunarYear{
+	{{- range .}}
+	{ {{- .NewYear}}, {{.LeapMonth}}, {{printf "%#04x" .BigMonths -}} }, // {{.Year}}
+	{{- end}}
+}
+`
+
+var solarTermTemplate = `// Code generated by "scripts/generator"; DO NOT EDIT.
+
+package chinesecalendar
+
+// solarTermMinutes {{(index . 0).Year}} 年至 {{(index . (len . | add -1)).Year}} 年每年从小寒到冬至 24 个节气的时刻，
+// 为距该年 1 月 1 日零点（中国标准时间）的分钟数
+var solarTermMinutes = [...][24]int32{
+	{{- range .}}
+	{ {{- range $i, $m := .Minutes}}{{if $i}}, {{end}}{{$m}}{{end -}} }, // {{.Year}}
+	{{- end}}
+}
+`
+
+var regionTemplate = `// Code generated by "scripts/generator"; DO NOT EDIT.
+
+package chinesecalendar
+
+// regionCalendars 香港、澳门、台湾的放假安排，见 RegionCalendar
+var regionCalendars = map[Region]*Calendar{
+	{{- range .}}
+	{{.Region}}: {
+		feed:   regionFeeds[{{.Region}}],
+		minDay: civilDate{ {{- .MinDay.Year}}, {{.MinDay.Month | printf "%d"}}, {{.MinDay.Day -}} },
+		maxDay: civilDate{ {{- .MaxDay.Year}}, {{.MaxDay.Month | printf "%d"}}, {{.MaxDay.Day -}} },
+		tables: []yearTable{
+			{{- range .YearTables}}
+			{
+				year:       {{.Year}},
+				holidays:   dayBits{ {{- range $i, $w := .Holidays}}{{if $i}}, {{end}}{{printf "%#016x" $w}}{{end -}} },
+				workdays:   dayBits{ {{- range $i, $w := .Workdays}}{{if $i}}, {{end}}{{printf "%#016x" $w}}{{end -}} },
+				inLieuDays: dayBits{ {{- range $i, $w := .InLieuDays}}{{if $i}}, {{end}}{{printf "%#016x" $w}}{{end -}} },
+				statutory:  dayBits{ {{- range $i, $w := .Statutory}}{{if $i}}, {{end}}{{printf "%#016x" $w}}{{end -}} },
+				tags: []dayTag{
+					{{- range .Tags}}
+					{ {{- .First}}, {{.Last}}, {{.Holiday -}} },
+					{{- end}}
+				},
+			},
+			{{- end}}
+		},
+	},
+	{{- end}}
+}
+`
+
+var provinceTemplate = `// Code generated by "scripts/generator"; DO NOT EDIT.
+
+package chinesecalendar
+
+// provinceMinDay、provinceMaxDay 地方节日数据覆盖的第一天与最后一天
+var (
+	provinceMinDay = civilDate{ {{- .MinYear}}, 1, 1}
+	provinceMaxDay = civilDate{ {{- .MaxYear}}, 12, 31}
 )
+
+// provinceHolidays 各省、自治区的地方节日，见 Calendar.WithProvince
+var provinceHolidays = map[Province][]provinceDay{
+	{{- range .Provinces}}
+	{{.Province}}: {
+		{{- range .Days}}
+		{civilDate{ {{- .Date.Year}}, {{.Date.Month | printf "%d"}}, {{.Date.Day -}} }, {{.Holiday -}} },
+		{{- end}}
+	},
+	{{- end}}
+}
 `
 
-func generate() string {
+var yamlTemplate = `# Code generated by "scripts/generator"; DO NOT EDIT.
+# 每个有放假安排的日期一条记录，字段含义与 data/chinesecalendar.json 相同
+version: {{.Version}}
+start: "{{.Start}}"
+end: "{{.End}}"
+days:
+{{- range .Days}}
+  - {date: "{{.Date}}", year: {{.Year}}, type: {{.Type}}, holiday: {{.Holiday}}, name: {{json .Name}}, engName: {{json .EngName}}, statutory: {{.Statutory}}, inLieu: {{.InLieu}}}
+{{- end}}
+`
+
+// dataFile data/chinesecalendar.json 的内容
+type dataFile struct {
+	Version int    `json:"version"`
+	Start   string `json:"start"`
+	End     string `json:"end"`
+	Days    []struct {
+		Date      string `json:"date"`
+		Year      int    `json:"year"`
+		Type      string `json:"type"`
+		Holiday   string `json:"holiday"`
+		Name      string `json:"name"`
+		EngName   string `json:"engName"`
+		Statutory bool   `json:"statutory"`
+		InLieu    bool   `json:"inLieu"`
+	} `json:"days"`
+}
+
+func generate() (*arrangement, string) {
 	ag := newArragement()
 	ag.generateHolidays()
 	t := template.Must(template.New("").Parse(arrangementTemplate))
 
 	buffer := &bytes.Buffer{}
 	t.Execute(buffer, ag)
-	return buffer.String()
+	return ag, buffer.String()
 }
 
-func main() {
-	str := generate()
-	file, err := os.Create("constants.go")
+// calendar 用放假安排构建日历，用于导出数据
+func (ag *arrangement) calendar() *chinesecalendar.Calendar {
+	arrangements := make([]chinesecalendar.Arrangement, 0, len(ag.HolidayList)+len(ag.WorkdayList))
+	for _, t := range ag.HolidayList {
+		_, isInLieu := ag.InLieuDays[t]
+		arrangements = append(arrangements, chinesecalendar.Arrangement{
+			Date:      t,
+			Holiday:   ag.Holidays[t],
+			Statutory: ag.Statutory[t],
+			InLieu:    isInLieu,
+		})
+	}
+	for _, t := range ag.WorkdayList {
+		arrangements = append(arrangements, chinesecalendar.Arrangement{
+			Date:    t,
+			Holiday: ag.Workdays[t],
+			Workday: true,
+		})
+	}
+
+	c, err := chinesecalendar.NewCalendar(ag.MinDay, ag.MaxDay, arrangements)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// generateData 导出 JSON 与 YAML 格式的数据
+func (ag *arrangement) generateData() (string, string) {
+	jsonBuffer := &bytes.Buffer{}
+	if err := ag.calendar().WriteJSON(jsonBuffer); err != nil {
+		panic(err)
+	}
+
+	var data dataFile
+	if err := json.Unmarshal(jsonBuffer.Bytes(), &data); err != nil {
+		panic(err)
+	}
+	t := template.Must(template.New("").Funcs(template.FuncMap{
+		"json": func(s string) (string, error) {
+			b, err := json.Marshal(s)
+			return string(b), err
+		},
+	}).Parse(yamlTemplate))
+	yamlBuffer := &bytes.Buffer{}
+	if err := t.Execute(yamlBuffer, data); err != nil {
+		panic(err)
+	}
+	return jsonBuffer.String(), yamlBuffer.String()
+}
+
+// 农历的计算以东八区（东经 120°）的日期为准
+var chinaStandardTime = time.FixedZone("CST", 8*60*60)
+
+const (
+	lunarMinYear = 1900
+	lunarMaxYear = 2100
+)
+
+// lunarMonth 农历月：初一的公历日期（UTC 零点），月份，是否闰月
+type lunarMonth struct {
+	start time.Time
+	month int
+	leap  bool
+}
+
+// lunarYearData 一个农历年，见 lunar.go 中的 lunarYear
+type lunarYearData struct {
+	Year      int
+	NewYear   int
+	LeapMonth int
+	BigMonths uint16
+}
+
+// chinaDate 返回时刻 t 在东八区的日期
+func chinaDate(t time.Time) time.Time {
+	year, month, day := t.In(chinaStandardTime).Date()
+	return Date(year, int(month), day)
+}
+
+// newMoonDate 返回第 k 个朔所在的日期，即农历月的初一
+func newMoonDate(k int) time.Time {
+	return chinaDate(astro.NewMoon(k))
+}
+
+// month11 返回 year 年冬至所在农历月（十一月）的朔的序号，冬至当天为朔时即为该月初一
+func month11(year int) int {
+	winterSolstice := chinaDate(astro.SolarLongitudeTime(year, 270))
+	endOfDay := time.Date(winterSolstice.Year(), winterSolstice.Month(), winterSolstice.Day(), 23, 59, 59, 0, chinaStandardTime)
+	return astro.NewMoonIndex(endOfDay)
+}
+
+// hasPrincipalTerm 检查 [start, end) 内是否有中气（太阳视黄经为 30° 的整数倍）
+func hasPrincipalTerm(start, end time.Time) bool {
+	for year := start.Year() - 1; year <= end.Year(); year++ {
+		for longitude := 0; longitude < 360; longitude += 30 {
+			d := chinaDate(astro.SolarLongitudeTime(year, float64(longitude)))
+			if !d.Before(start) && d.Before(end) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// suiMonths 返回 year - 1 年冬至至 year 年冬至之间（一岁）的农历月，从十一月开始。
+// 一岁有 13 个月时，第一个没有中气的月为闰月，沿用上一个月的月份
+func suiMonths(year int) []lunarMonth {
+	first, last := month11(year-1), month11(year)
+	leap := last-first == 13
+
+	var months []lunarMonth
+	month := 11
+	for k := first; k < last; k++ {
+		m := lunarMonth{start: newMoonDate(k), month: month}
+		if leap && k > first && !hasPrincipalTerm(m.start, newMoonDate(k+1)) {
+			m.month, m.leap = months[len(months)-1].month, true
+			leap = false
+		}
+		months = append(months, m)
+		month = m.month%12 + 1
+	}
+	return months
+}
+
+// generateLunarYears 计算农历 lunarMinYear 年至 lunarMaxYear 年的数据
+func generateLunarYears() []lunarYearData {
+	var months []lunarMonth
+	for year := lunarMinYear; year <= lunarMaxYear+1; year++ {
+		months = append(months, suiMonths(year)...)
+	}
+	// 最后一个月的结束日期
+	end := newMoonDate(month11(lunarMaxYear + 1))
+	epoch := Date(lunarMinYear, 1, 31)
+
+	var years []lunarYearData
+	order := 0
+	for i, m := range months {
+		if m.month == 1 && !m.leap {
+			if m.start.Year() > lunarMaxYear {
+				break
+			}
+			years = append(years, lunarYearData{Year: m.start.Year(), NewYear: int(m.start.Sub(epoch) / (24 * time.Hour))})
+			order = 0
+		}
+		if len(years) == 0 {
+			continue
+		}
+
+		next := end
+		if i+1 < len(months) {
+			next = months[i+1].start
+		}
+		days := int(next.Sub(m.start) / (24 * time.Hour))
+		if days != 29 && days != 30 {
+			panic(fmt.Sprintf("lunar month starting %s has %d days", m.start.Format("2006-01-02"), days))
+		}
+
+		y := &years[len(years)-1]
+		if m.leap {
+			y.LeapMonth = m.month
+		}
+		if days == 30 {
+			y.BigMonths |= 1 << uint(order)
+		}
+		order++
+	}
+
+	if years[0].Year != lunarMinYear || years[0].NewYear != 0 || len(years) != lunarMaxYear-lunarMinYear+1 {
+		panic(fmt.Sprintf("unexpected lunar years: %d from %d", len(years), years[0].Year))
+	}
+	return years
+}
+
+// solarTermYearData 一年中 24 个节气的时刻，见 solarterm.go 中的 solarTermMinutes
+type solarTermYearData struct {
+	Year    int
+	Minutes [24]int
+}
+
+// generateSolarTerms 计算 lunarMinYear 年至 lunarMaxYear 年每年从小寒开始的 24 个节气，
+// 以距该年 1 月 1 日零点（东八区）的分钟数表示，不足一分钟的部分舍去
+func generateSolarTerms() []solarTermYearData {
+	var years []solarTermYearData
+	for year := lunarMinYear; year <= lunarMaxYear; year++ {
+		data := solarTermYearData{Year: year}
+		start := time.Date(year, 1, 1, 0, 0, 0, 0, chinaStandardTime)
+		for i := range data.Minutes {
+			t := astro.SolarLongitudeTime(year, float64(285+15*i))
+			if t.In(chinaStandardTime).Year() != year {
+				panic(fmt.Sprintf("solar term %d of %d is at %s", i, year, t))
+			}
+			data.Minutes[i] = int(t.Sub(start) / time.Minute)
+		}
+		years = append(years, data)
+	}
+	return years
+}
+
+// 香港、澳门、台湾放假安排的年份范围，台湾的弹性放假与补班见 taiwanAdjustments
+const (
+	regionMinYear = 2023
+	regionMaxYear = 2025
+)
+
+// regionHoliday 按规则确定的一天公众假期
+type regionHoliday struct {
+	date    time.Time
+	holiday chinesecalendar.Holiday
+}
+
+// regionData region_data.go 中一个地区的数据，Region 为 chinesecalendar.Region 的常量名
+type regionData struct {
+	Region string
+	*arrangement
+}
+
+func newRegionArrangement() *arrangement {
+	ag := newArragement()
+	ag.MinDay, ag.MaxDay = Date(regionMinYear, 1, 1), Date(regionMaxYear, 12, 31)
+	return ag
+}
+
+// lunarDate 农历 year 年 month 月 day 日的公历日期
+func lunarDate(year, month, day int) time.Time {
+	t, err := chinesecalendar.LunarDate{Year: year, Month: month, Day: day}.Time(time.UTC)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// solarTermDate 节气所在的日期（东八区）
+func solarTermDate(year int, st chinesecalendar.SolarTerm) time.Time {
+	t, err := chinesecalendar.GetSolarTermTime(year, st)
+	if err != nil {
+		panic(err)
+	}
+	y, m, d := t.Date()
+	return Date(y, int(m), d)
+}
+
+// easterSunday 复活节的日期（格里高利历），Meeus/Jones/Butcher 算法
+func easterSunday(year int) time.Time {
+	a, b, c := year%19, year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	return Date(year, (h+l-7*m+114)/31, (h+l-7*m+114)%31+1)
+}
+
+func isSunday(t time.Time) bool {
+	return t.Weekday() == time.Sunday
+}
+
+func isWeekend(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
+
+// publicHolidays 将 list 标记为法定假日，返回与已有假期同日而没有标记的假期
+func (ag *arrangement) publicHolidays(list []regionHoliday) []regionHoliday {
+	var clashed []regionHoliday
+	for _, h := range list {
+		if _, ok := ag.Holidays[h.date]; ok {
+			clashed = append(clashed, h)
+			continue
+		}
+		ag.Holidays[h.date] = h.holiday
+		ag.Statutory[h.date] = true
+	}
+	return clashed
+}
+
+// substitute 从 t 起按 step 逐日查找第一个不是假期、skip 也不跳过的日期，标记为 holiday 的补假（替代日）
+func (ag *arrangement) substitute(t time.Time, step int, skip func(time.Time) bool, holiday chinesecalendar.Holiday) {
+	for {
+		t = t.AddDate(0, 0, step)
+		if _, ok := ag.Holidays[t]; !ok && !skip(t) {
+			break
+		}
+	}
+	ag.Holidays[t] = holiday
+	ag.InLieuDays[t] = holiday
+}
+
+// hongKong 按香港《公众假期条例》生成公众假期：
+// 假期逢星期日，或与另一假期同日时，于其后第一个不是星期日、也不是假期的日子补假；
+// 农历年初一至初三任何一日逢星期日时年初四补假，与上述规则的结果相同
+func hongKong() *arrangement {
+	ag := newRegionArrangement()
+	for year := regionMinYear; year <= regionMaxYear; year++ {
+		easter, newYear := easterSunday(year), lunarDate(year, 1, 1)
+		list := []regionHoliday{
+			{Date(year, 1, 1), chinesecalendar.NewYearsDay},
+			{newYear, chinesecalendar.SpringFestival},
+			{newYear.AddDate(0, 0, 1), chinesecalendar.SpringFestival},
+			{newYear.AddDate(0, 0, 2), chinesecalendar.SpringFestival},
+			{solarTermDate(year, chinesecalendar.PureBrightness), chinesecalendar.TombSweepingDay},
+			{easter.AddDate(0, 0, -2), chinesecalendar.GoodFriday},
+			{easter.AddDate(0, 0, -1), chinesecalendar.EasterSaturday},
+			{easter.AddDate(0, 0, 1), chinesecalendar.EasterMonday},
+			{Date(year, 5, 1), chinesecalendar.LabourDay},
+			{lunarDate(year, 4, 8), chinesecalendar.BuddhasBirthday},
+			{lunarDate(year, 5, 5), chinesecalendar.DragonBoatFestival},
+			{Date(year, 7, 1), chinesecalendar.HKSAREstablishmentDay},
+			{lunarDate(year, 8, 16), chinesecalendar.MidAutumnNextDay},
+			{Date(year, 10, 1), chinesecalendar.NationalDay},
+			{lunarDate(year, 9, 9), chinesecalendar.ChungYeungFestival},
+			{Date(year, 12, 25), chinesecalendar.ChristmasDay},
+			{Date(year, 12, 26), chinesecalendar.BoxingDay},
+		}
+		clashed := ag.publicHolidays(list)
+		for _, h := range list {
+			if isSunday(h.date) && ag.Holidays[h.date] == h.holiday {
+				ag.substitute(h.date, 1, isSunday, h.holiday)
+			}
+		}
+		for _, h := range clashed {
+			ag.substitute(h.date, 1, isSunday, h.holiday)
+		}
+	}
+	return ag
+}
+
+// macao 按澳门的公众假日生成，逢周末不补假；政府给予公务人员的补假每年另行公布，不包括在内
+func macao() *arrangement {
+	ag := newRegionArrangement()
+	for year := regionMinYear; year <= regionMaxYear; year++ {
+		easter, newYear := easterSunday(year), lunarDate(year, 1, 1)
+		list := []regionHoliday{
+			{Date(year, 1, 1), chinesecalendar.NewYearsDay},
+			{newYear, chinesecalendar.SpringFestival},
+			{newYear.AddDate(0, 0, 1), chinesecalendar.SpringFestival},
+			{newYear.AddDate(0, 0, 2), chinesecalendar.SpringFestival},
+			{solarTermDate(year, chinesecalendar.PureBrightness), chinesecalendar.TombSweepingDay},
+			{easter.AddDate(0, 0, -2), chinesecalendar.GoodFriday},
+			{easter.AddDate(0, 0, -1), chinesecalendar.EasterSaturday},
+			{Date(year, 5, 1), chinesecalendar.LabourDay},
+			{lunarDate(year, 4, 8), chinesecalendar.BuddhasBirthday},
+			{lunarDate(year, 5, 5), chinesecalendar.DragonBoatFestival},
+			{lunarDate(year, 8, 16), chinesecalendar.MidAutumnNextDay},
+			{Date(year, 10, 1), chinesecalendar.NationalDay},
+			{Date(year, 10, 2), chinesecalendar.NationalDay},
+			{lunarDate(year, 9, 9), chinesecalendar.ChungYeungFestival},
+			{Date(year, 11, 2), chinesecalendar.AllSoulsDay},
+			{Date(year, 12, 8), chinesecalendar.ImmaculateConception},
+			{solarTermDate(year, chinesecalendar.WinterSolstice), chinesecalendar.WinterSolsticeFestival},
+			{Date(year, 12, 20), chinesecalendar.MacaoSAREstablishmentDay},
+			{Date(year, 12, 24), chinesecalendar.ChristmasEve},
+			{Date(year, 12, 25), chinesecalendar.ChristmasDay},
+		}
+		if clashed := ag.publicHolidays(list); len(clashed) > 0 {
+			panic(fmt.Sprintf("%s of %d clashes with another holiday", clashed[0].holiday.Name(), year))
+		}
+	}
+	return ag
+}
+
+// taiwanAdjustments 行政院人事行政总处公布的弹性放假日及对应的补班日，2025 年起不再安排补班
+var taiwanAdjustments = []struct {
+	rest, work time.Time
+	holiday    chinesecalendar.Holiday
+}{
+	{Date(2023, 1, 20), Date(2023, 1, 7), chinesecalendar.SpringFestival},
+	{Date(2023, 1, 27), Date(2023, 2, 4), chinesecalendar.SpringFestival},
+	{Date(2023, 2, 27), Date(2023, 2, 18), chinesecalendar.PeaceMemorialDay},
+	{Date(2023, 4, 3), Date(2023, 3, 25), chinesecalendar.ChildrensDay},
+	{Date(2023, 6, 23), Date(2023, 6, 17), chinesecalendar.DragonBoatFestival},
+	{Date(2023, 10, 9), Date(2023, 9, 23), chinesecalendar.DoubleTenthDay},
+	{Date(2024, 2, 8), Date(2024, 2, 17), chinesecalendar.SpringFestival},
+}
+
+// taiwan 按《纪念日及节日实施条例》（2025 年起）及此前的《纪念日及节日实施办法》生成放假日：
+// 除夕、春节（2025 年起包括除夕前一日）逢周末时于其后补假；
+// 其余假日逢星期六于前一个上班日补假，逢星期日于次一个上班日补假；
+// 儿童节与清明同日时于前一日放假，该日为星期四时于后一日放假。
+// 弹性放假与补班见 taiwanAdjustments
+func taiwan() *arrangement {
+	ag := newRegionArrangement()
+	for year := regionMinYear; year <= regionMaxYear; year++ {
+		newYear := lunarDate(year, 1, 1)
+		spring := []regionHoliday{
+			{newYear.AddDate(0, 0, -1), chinesecalendar.SpringFestival},
+			{newYear, chinesecalendar.SpringFestival},
+			{newYear.AddDate(0, 0, 1), chinesecalendar.SpringFestival},
+			{newYear.AddDate(0, 0, 2), chinesecalendar.SpringFestival},
+		}
+		if year >= 2025 {
+			spring = append(spring, regionHoliday{newYear.AddDate(0, 0, -2), chinesecalendar.SpringFestival})
+		}
+
+		tombSweepingDay, childrensDay := solarTermDate(year, chinesecalendar.PureBrightness), Date(year, 4, 4)
+		if childrensDay.Equal(tombSweepingDay) {
+			if childrensDay.Weekday() == time.Thursday {
+				childrensDay = childrensDay.AddDate(0, 0, 1)
+			} else {
+				childrensDay = childrensDay.AddDate(0, 0, -1)
+			}
+		}
+		others := []regionHoliday{
+			{Date(year, 1, 1), chinesecalendar.NewYearsDay},
+			{Date(year, 2, 28), chinesecalendar.PeaceMemorialDay},
+			{childrensDay, chinesecalendar.ChildrensDay},
+			{tombSweepingDay, chinesecalendar.TombSweepingDay},
+			{lunarDate(year, 5, 5), chinesecalendar.DragonBoatFestival},
+			{lunarDate(year, 8, 15), chinesecalendar.MidAutumnFestival},
+			{Date(year, 10, 10), chinesecalendar.DoubleTenthDay},
+		}
+		if year >= 2025 {
+			others = append(others,
+				regionHoliday{Date(year, 9, 28), chinesecalendar.ConfuciusBirthday},
+				regionHoliday{Date(year, 10, 25), chinesecalendar.RetrocessionDay},
+				regionHoliday{Date(year, 12, 25), chinesecalendar.ConstitutionDay},
+			)
+		}
+		if year >= 2026 {
+			others = append(others, regionHoliday{Date(year, 5, 1), chinesecalendar.LabourDay})
+		}
+
+		if clashed := ag.publicHolidays(append(spring, others...)); len(clashed) > 0 {
+			panic(fmt.Sprintf("%s of %d clashes with another holiday", clashed[0].holiday.Name(), year))
+		}
+		for _, h := range spring {
+			if isWeekend(h.date) {
+				ag.substitute(h.date, 1, isWeekend, h.holiday)
+			}
+		}
+		for _, h := range others {
+			switch h.date.Weekday() {
+			case time.Saturday:
+				ag.substitute(h.date, -1, isWeekend, h.holiday)
+			case time.Sunday:
+				ag.substitute(h.date, 1, isWeekend, h.holiday)
+			}
+		}
+	}
+
+	for _, a := range taiwanAdjustments {
+		if _, ok := ag.Holidays[a.rest]; ok || isWeekend(a.rest) || !isWeekend(a.work) {
+			panic(fmt.Sprintf("invalid adjustment %s", a.rest.Format("2006-01-02")))
+		}
+		ag.Holidays[a.rest] = a.holiday
+		ag.InLieuDays[a.rest] = a.holiday
+		ag.Workdays[a.work] = a.holiday
+	}
+	return ag
+}
+
+// generateRegions 生成香港、澳门、台湾的放假安排
+func generateRegions() []regionData {
+	regions := []regionData{{"HongKong", hongKong()}, {"Macao", macao()}, {"Taiwan", taiwan()}}
+	for _, r := range regions {
+		r.generateYearTables()
+	}
+	return regions
+}
+
+// 省、自治区地方节日的年份范围
+const (
+	provinceMinYear = 2023
+	provinceMaxYear = 2025
+)
+
+// islamicFestivals 中国伊斯兰教协会公布的开斋节（肉孜节）与古尔邦节的日期
+var islamicFestivals = map[int][2]time.Time{
+	2023: {Date(2023, 4, 22), Date(2023, 6, 29)},
+	2024: {Date(2024, 4, 10), Date(2024, 6, 17)},
+	2025: {Date(2025, 3, 31), Date(2025, 6, 7)},
+}
+
+// tibetanNewYears 藏历新年（藏历正月初一）的日期
+var tibetanNewYears = map[int]time.Time{
+	2023: Date(2023, 2, 21),
+	2024: Date(2024, 2, 10),
+	2025: Date(2025, 2, 28),
+}
+
+// provinceDay province_data.go 中地方节日放假的一天
+type provinceDay struct {
+	Date    time.Time
+	Holiday string
+}
+
+// provinceData province_data.go 中一个省、自治区的数据，Province 为 chinesecalendar.Province 的常量名
+type provinceData struct {
+	Province string
+	Days     []provinceDay
+}
+
+// provinceSource province_data.go 的数据，MinYear、MaxYear 为数据覆盖的年份
+type provinceSource struct {
+	MinYear   int
+	MaxYear   int
+	Provinces []provinceData
+}
+
+// festivalDays 从 first 开始连续 holiday.Days() 天的地方节日
+func (ag *arrangement) festivalDays(first time.Time, holiday chinesecalendar.Holiday) []provinceDay {
+	days := make([]provinceDay, 0, holiday.Days())
+	for i := 0; i < holiday.Days(); i++ {
+		days = append(days, provinceDay{first.AddDate(0, 0, i), ag.HolidayFieldMap[holiday]})
+	}
+	return days
+}
+
+// generateProvinces 按各省、自治区实施《全国年节及纪念日放假办法》的规定生成地方节日：
+// 新疆肉孜节、古尔邦节从节日当天起放假，西藏藏历新年从正月初一起放假，广西三月三为农历三月初三、初四
+func generateProvinces() provinceSource {
+	ag := newArragement()
+	var xinjiang, tibet, guangxi []provinceDay
+	for year := provinceMinYear; year <= provinceMaxYear; year++ {
+		islamic, ok := islamicFestivals[year]
+		if !ok {
+			panic(fmt.Sprintf("no Islamic festival dates of %d", year))
+		}
+		newYear, ok := tibetanNewYears[year]
+		if !ok {
+			panic(fmt.Sprintf("no Tibetan new year of %d", year))
+		}
+		xinjiang = append(xinjiang, ag.festivalDays(islamic[0], chinesecalendar.EidAlFitr)...)
+		xinjiang = append(xinjiang, ag.festivalDays(islamic[1], chinesecalendar.EidAlAdha)...)
+		tibet = append(tibet, ag.festivalDays(newYear, chinesecalendar.TibetanNewYear)...)
+		guangxi = append(guangxi, ag.festivalDays(lunarDate(year, 3, 3), chinesecalendar.SanyuesanFestival)...)
+	}
+	return provinceSource{provinceMinYear, provinceMaxYear, []provinceData{{"Xinjiang", xinjiang}, {"Tibet", tibet}, {"Guangxi", guangxi}}}
+}
+
+// generateSource 用模板生成 Go 代码并格式化
+func generateSource(text string, data interface{}) string {
+	buffer := &bytes.Buffer{}
+	t := template.Must(template.New("").Funcs(template.FuncMap{"add": func(a, b int) int { return a + b }}).Parse(text))
+	if err := t.Execute(buffer, data); err != nil {
+		panic(err)
+	}
+	source, err := format.Source(buffer.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	return string(source)
+}
+
+func writeFile(name, content string) {
+	file, err := os.Create(name)
 	if err != nil {
 		panic(err)
 	}
 	defer file.Close()
 
-	_, err = file.Write([]byte(str))
+	_, err = file.Write([]byte(content))
 	if err != nil {
 		panic(err)
 	}
+}
+
+func main() {
+	ag, str := generate()
+	writeFile("constants.go", str)
+
+	jsonData, yamlData := ag.generateData()
+	if err := os.MkdirAll("data", 0755); err != nil {
+		panic(err)
+	}
+	writeFile("data/chinesecalendar.json", jsonData)
+	writeFile("data/chinesecalendar.yaml", yamlData)
+
+	writeFile("lunar_data.go", generateSource(lunarTemplate, generateLunarYears()))
+	writeFile("solarterm_data.go", generateSource(solarTermTemplate, generateSolarTerms()))
+	writeFile("region_data.go", generateSource(regionTemplate, generateRegions()))
+	writeFile("province_data.go", generateSource(provinceTemplate, generateProvinces()))
 
 	exec.Command("gofmt", "-w constants.go")
 }
@@ -0,0 +1,180 @@
+package chinesecalendar
+
+import (
+	"sort"
+	"time"
+)
+
+// SolarTerm 二十四节气，按在公历年中的先后顺序，从小寒开始
+type SolarTerm int
+
+const (
+	// MinorCold 小寒
+	MinorCold SolarTerm = iota
+	// MajorCold 大寒
+	MajorCold
+	// StartOfSpring 立春
+	StartOfSpring
+	// RainWater 雨水
+	RainWater
+	// AwakeningOfInsects 惊蛰
+	AwakeningOfInsects
+	// SpringEquinox 春分
+	SpringEquinox
+	// PureBrightness 清明
+	PureBrightness
+	// GrainRain 谷雨
+	GrainRain
+	// StartOfSummer 立夏
+	StartOfSummer
+	// GrainBuds 小满
+	GrainBuds
+	// GrainInEar 芒种
+	GrainInEar
+	// SummerSolstice 夏至
+	SummerSolstice
+	// MinorHeat 小暑
+	MinorHeat
+	// MajorHeat 大暑
+	MajorHeat
+	// StartOfAutumn 立秋
+	StartOfAutumn
+	// EndOfHeat 处暑
+	EndOfHeat
+	// WhiteDew 白露
+	WhiteDew
+	// AutumnEquinox 秋分
+	AutumnEquinox
+	// ColdDew 寒露
+	ColdDew
+	// FrostsDescent 霜降
+	FrostsDescent
+	// StartOfWinter 立冬
+	StartOfWinter
+	// MinorSnow 小雪
+	MinorSnow
+	// MajorSnow 大雪
+	MajorSnow
+	// WinterSolstice 冬至
+	WinterSolstice
+)
+
+var solarTermNames = [...]string{
+	"小寒", "大寒", "立春", "雨水", "惊蛰", "春分", "清明", "谷雨", "立夏", "小满", "芒种", "夏至",
+	"小暑", "大暑", "立秋", "处暑", "白露", "秋分", "寒露", "霜降", "立冬", "小雪", "大雪", "冬至",
+}
+
+var solarTermEngNames = [...]string{
+	"Minor Cold", "Major Cold", "Start of Spring", "Rain Water", "Awakening of Insects", "Spring Equinox",
+	"Pure Brightness", "Grain Rain", "Start of Summer", "Grain Buds", "Grain in Ear", "Summer Solstice",
+	"Minor Heat", "Major Heat", "Start of Autumn", "End of Heat", "White Dew", "Autumn Equinox",
+	"Cold Dew", "Frost's Descent", "Start of Winter", "Minor Snow", "Major Snow", "Winter Solstice",
+}
+
+func (st SolarTerm) valid() bool {
+	return st >= 0 && int(st) < len(solarTermNames)
+}
+
+// String 返回节气的中文名，例如“清明”
+func (st SolarTerm) String() string {
+	if !st.valid() {
+		return "未知"
+	}
+	return solarTermNames[st]
+}
+
+// EngName 返回节气的英文名，例如 "Pure Brightness"
+func (st SolarTerm) EngName() string {
+	if !st.valid() {
+		return ""
+	}
+	return solarTermEngNames[st]
+}
+
+// Longitude 返回节气对应的太阳视黄经（度），春分为 0，冬至为 270
+func (st SolarTerm) Longitude() int {
+	return (285 + 15*int(st)) % 360
+}
+
+// solarTermMinYear solarTermMinutes 的第一年
+const solarTermMinYear = 1900
+
+// solarTermTime 返回 year 年第 st 个节气的时刻，year 超出范围时返回 false
+func solarTermTime(year int, st SolarTerm) (time.Time, bool) {
+	n := year - solarTermMinYear
+	if n < 0 || n >= len(solarTermMinutes) || !st.valid() {
+		return time.Time{}, false
+	}
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, ChinaStandardTime)
+	return start.Add(time.Duration(solarTermMinutes[n][st]) * time.Minute), true
+}
+
+// GetSolarTermTime 返回 year 年节气 st 的交节时刻，为中国标准时间，精确到分钟。
+// 支持 1900 年至 2100 年，超出范围时返回 ErrUnSupportSolarTermDate
+func GetSolarTermTime(year int, st SolarTerm) (time.Time, error) {
+	t, ok := solarTermTime(year, st)
+	if !ok {
+		return time.Time{}, ErrUnSupportSolarTermDate
+	}
+	return t, nil
+}
+
+// GetSolarTerms 返回 year 年 24 个节气的交节时刻，以 SolarTerm 为下标，见 GetSolarTermTime
+func GetSolarTerms(year int) ([]time.Time, error) {
+	list := make([]time.Time, 0, len(solarTermNames))
+	for st := MinorCold; st <= WinterSolstice; st++ {
+		t, ok := solarTermTime(year, st)
+		if !ok {
+			return []time.Time{}, ErrUnSupportSolarTermDate
+		}
+		list = append(list, t)
+	}
+	return list, nil
+}
+
+// solarTermDay 返回节气所在的日期（中国标准时间）
+func solarTermDay(year int, st SolarTerm) civilDate {
+	t, _ := solarTermTime(year, st)
+	return civilDateOf(t)
+}
+
+// GetSolarTerm 返回 t 所在的节气，即 t 的日期当天或之前最近的一个节气，以及该节气所在的日期（ChinaStandardTime 的零点）。
+// 节气按交节时刻在中国标准时间下的日期计算，t 按其在中国的日期判断
+func GetSolarTerm(t time.Time) (SolarTerm, time.Time, error) {
+	d := civilDateOf(t)
+	if n := d.year - solarTermMinYear; n >= 0 && n < len(solarTermMinutes) {
+		// 当年第一个晚于 d 的节气的前一个
+		k := sort.Search(len(solarTermNames), func(i int) bool {
+			return solarTermDay(d.year, SolarTerm(i)).after(d)
+		}) - 1
+		if k >= 0 {
+			return SolarTerm(k), solarTermDay(d.year, SolarTerm(k)).time(ChinaStandardTime), nil
+		}
+	} else if n != len(solarTermMinutes) {
+		return 0, time.Time{}, ErrUnSupportSolarTermDate
+	}
+
+	// d 早于当年的小寒，属于上一年的冬至。没有当年的数据时（2101 年），
+	// 只有冬至后 minWinterSolsticeGap 天之内的日期一定早于小寒
+	year := d.year - 1
+	if year < solarTermMinYear {
+		return 0, time.Time{}, ErrUnSupportSolarTermDate
+	}
+	winterSolstice := solarTermDay(year, WinterSolstice)
+	if year-solarTermMinYear == len(solarTermMinutes)-1 && !d.before(winterSolstice.addDays(minWinterSolsticeGap)) {
+		return 0, time.Time{}, ErrUnSupportSolarTermDate
+	}
+	return WinterSolstice, winterSolstice.time(ChinaStandardTime), nil
+}
+
+// minWinterSolsticeGap 冬至至次年小寒的最少天数（按日期计算，1900 年至 2100 年）
+const minWinterSolsticeGap = 14
+
+// IsSolarTermDay 检查 t 的日期是否为交节日，是时返回节气
+func IsSolarTermDay(t time.Time) (SolarTerm, bool) {
+	st, day, err := GetSolarTerm(t)
+	if err != nil || civilDateOf(day) != civilDateOf(t) {
+		return 0, false
+	}
+	return st, true
+}
@@ -0,0 +1,209 @@
+// Code generated by "scripts/generator"; DO NOT EDIT.
+
+package chinesecalendar
+
+// solarTermMinutes 1900 年至 2100 年每年从小寒到冬至 24 个节气的时刻，
+// 为距该年 1 月 1 日零点（中国标准时间）的分钟数
+var solarTermMinutes = [...][24]int32{
+	{7324, 28532, 49791, 71161, 92662, 114339, 136192, 158247, 180475, 202877, 225399, 248019, 270670, 293316, 315890, 338359, 360676, 382820, 404773, 426535, 448119, 469547, 490855, 512081}, // 1900
+	{7673, 28876, 50139, 71504, 93010, 114683, 136544, 158593, 180830, 203224, 225756, 248368, 271027, 293664, 316246, 338707, 361030, 383168, 405126, 426886, 448474, 469901, 491212, 512436}, // 1901
+	{8031, 29232, 50498, 71859, 93367, 115036, 136897, 158944, 181178, 203573, 226099, 248715, 271366, 294009, 316582, 339053, 361366, 383515, 405465, 427235, 448817, 470255, 491561, 512795}, // 1902
+	{8383, 29593, 50851, 72220, 93718, 115394, 137245, 159298, 181525, 203925, 226447, 249065, 271716, 294358, 316935, 339401, 361722, 383863, 405821, 427583, 449173, 470601, 491915, 513140}, // 1903
+	{8737, 29937, 51204, 72564, 94071, 115738, 137598, 159642, 181878, 204269, 226801, 249411, 272071, 294709, 317291, 339756, 362077, 384220, 406175, 427939, 449524, 470955, 492265, 513494}, // 1904
+	{7647, 28852, 50116, 71481, 92985, 114657, 136514, 158563, 180794, 203191, 225713, 248331, 270980, 293625, 316197, 338668, 360981, 383129, 405079, 426847, 448429, 469864, 491170, 512403}, // 1905
+	{7993, 29203, 50464, 71834, 93336, 115012, 136867, 158919, 181148, 203545, 226069, 248681, 271335, 293972, 316551, 339013, 361336, 383475, 405435, 427194, 448786, 470213, 491529, 512753}, // 1906
+	{8351, 29550, 50819, 72178, 93687, 115353, 137214, 159257, 181493, 203883, 226413, 249023, 271679, 294318, 316896, 339363, 361682, 383829, 405782, 427551, 449136, 470572, 491879, 513111}, // 1907
+	{8701, 29908, 51167, 72534, 94033, 115707, 137560, 159611, 181838, 204238, 226759, 249379, 272028, 294674, 317246, 339717, 362032, 384178, 406130, 427896, 449482, 470914, 492223, 513453}, // 1908
+	{7605, 28811, 50072, 71438, 92941, 114613, 136469, 158518, 180751, 203145, 225674, 248285, 270944, 293580, 316162, 338623, 360946, 383084, 405043, 426802, 448393, 469820, 491134, 512359}, // 1909
+	{7958, 29159, 50427, 71788, 93296, 114963, 136823, 158865, 181099, 203490, 226016, 248628, 271281, 293923, 316497, 338967, 361282, 383430, 405381, 427151, 448733, 470171, 491477, 512711}, // 1910
+	{8301, 29511, 50770, 72140, 93639, 115314, 137164, 159216, 181440, 203838, 226358, 248975, 271625, 294268, 316844, 339313, 361633, 383777, 405734, 427498, 449087, 470515, 491827, 513053}, // 1911
+	{8647, 29849, 51113, 72475, 93981, 115649, 137508, 159552, 181787, 204177, 226707, 249317, 271976, 294613, 317197, 339661, 361985, 384128, 406086, 427850, 449438, 470868, 492178, 513404}, // 1912
+	{7558, 28759, 50022, 71384, 92889, 114558, 136416, 158463, 180694, 203090, 225613, 248229, 270879, 293523, 316095, 338568, 360882, 383032, 404983, 426754, 448337, 469775, 491081, 512314}, // 1913
+	{7902, 29111, 50369, 71738, 93235, 114910, 136761, 158813, 181040, 203437, 225960, 248575, 271227, 293867, 316445, 338909, 361232, 383373, 405334, 427097, 448691, 470120, 491437, 512662}, // 1914
+	{8260, 29459, 50725, 72083, 93588, 115251, 137109, 159148, 181382, 203770, 226300, 248909, 271567, 294206, 316787, 339255, 361577, 383723, 405680, 427449, 449037, 470473, 491783, 513015}, // 1915
+	{8607, 29813, 51074, 72438, 93937, 115606, 137457, 159504, 181729, 204126, 226645, 249264, 271913, 294561, 317135, 339608, 361925, 384074, 406027, 427797, 449382, 470817, 492126, 513358}, // 1916
+	{7509, 28717, 49977, 71344, 92844, 114517, 136370, 158417, 180645, 203038, 225563, 248174, 270830, 293467, 316050, 338513, 360839, 382980, 404942, 426703, 448296, 469724, 491040, 512265}, // 1917
+	{7864, 29064, 50333, 71692, 93200, 114865, 136725, 158765, 180998, 203385, 225911, 248519, 271172, 293811, 316387, 338857, 361175, 383325, 405280, 427052, 448638, 470078, 491386, 512621}, // 1918
+	{8211, 29420, 50679, 72047, 93545, 115219, 137068, 159118, 181342, 203739, 226256, 248873, 271520, 294164, 316738, 339208, 361527, 383675, 405633, 427401, 448991, 470425, 491737, 512967}, // 1919
+	{8560, 29764, 51026, 72389, 93891, 115559, 137415, 159459, 181691, 204082, 226610, 249219, 271878, 294514, 317098, 339561, 361886, 384028, 405989, 427752, 449344, 470775, 492090, 513317}, // 1920
+	{7473, 28674, 49940, 71300, 92805, 114471, 136328, 158372, 180604, 202996, 225521, 248135, 270786, 293430, 316003, 338475, 360789, 382939, 404890, 426662, 448245, 469684, 490991, 512227}, // 1921
+	{7817, 29028, 50286, 71656, 93153, 114828, 136678, 158728, 180953, 203350, 225870, 248486, 271137, 293779, 316357, 338824, 361146, 383289, 405249, 427012, 448605, 470035, 491350, 512576}, // 1922
+	{8174, 29374, 50640, 71999, 93504, 115168, 137026, 159065, 181298, 203685, 226214, 248822, 271482, 294120, 316704, 339171, 361497, 383643, 405603, 427370, 448960, 470393, 491704, 512933}, // 1923
+	{8525, 29728, 50989, 72351, 93852, 115520, 137373, 159418, 181645, 204040, 226561, 249179, 271829, 294477, 317052, 339528, 361845, 383998, 405952, 427724, 449309, 470746, 492053, 513285}, // 1924
+	{7433, 28640, 49896, 71263, 92759, 114432, 136282, 158331, 180558, 202953, 225476, 248090, 270745, 293384, 315967, 338433, 360760, 382903, 404867, 426631, 448226, 469655, 490972, 512196}, // 1925
+	{7794, 28992, 50258, 71614, 93119, 114781, 136638, 158676, 180908, 203294, 225821, 248430, 271085, 293724, 316304, 338774, 361095, 383246, 405204, 426978, 448567, 470007, 491318, 512553}, // 1926
+	{8144, 29351, 50610, 71974, 93470, 115139, 136986, 159031, 181253, 203648, 226165, 248782, 271430, 294076, 316651, 339125, 361445, 383596, 405555, 427326, 448917, 470354, 491666, 512898}, // 1927
+	{8491, 29696, 50956, 72319, 93817, 115484, 137334, 159376, 181603, 203992, 226517, 249126, 271784, 294422, 317007, 339473, 361801, 383945, 405910, 427674, 449269, 470700, 492017, 513243}, // 1928
+	{7402, 28602, 49868, 71226, 92732, 114395, 136251, 158290, 180520, 202907, 225431, 248040, 270691, 293333, 315908, 338381, 360699, 382852, 404807, 426581, 448167, 469608, 490916, 512152}, // 1929
+	{7742, 28953, 50211, 71579, 93076, 114749, 136597, 158645, 180867, 203262, 225778, 248392, 271039, 293682, 316257, 338726, 361048, 383196, 405157, 426926, 448520, 469954, 491270, 512499}, // 1930
+	{8095, 29297, 50560, 71920, 93422, 115086, 136940, 158979, 181209, 203595, 226121, 248728, 271385, 294021, 316604, 339070, 361397, 383543, 405506, 427275, 448869, 470304, 491620, 512849}, // 1931
+	{8445, 29646, 50909, 72268, 93769, 115433, 137286, 159328, 181555, 203946, 226467, 249082, 271732, 294378, 316951, 339426, 361742, 383895, 405849, 427623, 449209, 470650, 491958, 513194}, // 1932
+	{7343, 28552, 49809, 71176, 92671, 114343, 136190, 158238, 180461, 202857, 225377, 247992, 270644, 293285, 315865, 338332, 360657, 382801, 404763, 426528, 448122, 469553, 490871, 512097}, // 1933
+	{7696, 28897, 50163, 71521, 93026, 114688, 136543, 158580, 180810, 203194, 225721, 248327, 270984, 293622, 316203, 338672, 360996, 383145, 405104, 426876, 448466, 469904, 491216, 512449}, // 1934
+	{8042, 29248, 50508, 71872, 93370, 115037, 136886, 158930, 181152, 203544, 226061, 248677, 271325, 293973, 316547, 339024, 361344, 383498, 405455, 427229, 448817, 470255, 491564, 512797}, // 1935
+	{8386, 29592, 50849, 72213, 93709, 115377, 137226, 159271, 181496, 203887, 226410, 249021, 271678, 294317, 316903, 339370, 361700, 383845, 405812, 427578, 449174, 470604, 491922, 513146}, // 1936
+	{7303, 28501, 49765, 71120, 92624, 114285, 136141, 158179, 180410, 202797, 225322, 247932, 270586, 293226, 315805, 338277, 360599, 382752, 404710, 426486, 448075, 469516, 490826, 512061}, // 1937
+	{7651, 28858, 50115, 71479, 92973, 114643, 136488, 158534, 180755, 203150, 225666, 248283, 270931, 293577, 316152, 338625, 360948, 383099, 405061, 426833, 448428, 469866, 491181, 512413}, // 1938
+	{8007, 29210, 50470, 71829, 93326, 114988, 136837, 158875, 181101, 203486, 226011, 248619, 271278, 293916, 316503, 338971, 361302, 383449, 405416, 427185, 448783, 470218, 491536, 512765}, // 1939
+	{8363, 29564, 50827, 72183, 93684, 115343, 137194, 159231, 181456, 203843, 226364, 248976, 271628, 294274, 316851, 339328, 361649, 383805, 405762, 427539, 449126, 470568, 491877, 513114}, // 1940
+	{7264, 28473, 49729, 71096, 92590, 114260, 136105, 158150, 180370, 202763, 225279, 247893, 270543, 293186, 315765, 338236, 360563, 382712, 404678, 426447, 448044, 469477, 490795, 512024}, // 1941
+	{7622, 28823, 50088, 71446, 92949, 114610, 136463, 158499, 180726, 203108, 225632, 248236, 270891, 293527, 316110, 338578, 360906, 383056, 405021, 426795, 448391, 469830, 491146, 512379}, // 1942
+	{7974, 29179, 50440, 71800, 93298, 114962, 136811, 158851, 181073, 203462, 225979, 248592, 271238, 293884, 316458, 338935, 361255, 383411, 405370, 427148, 448738, 470181, 491492, 512729}, // 1943
+	{8319, 29527, 50783, 72147, 93640, 115308, 137154, 159197, 181419, 203811, 226331, 248942, 271596, 294235, 316818, 339286, 361615, 383761, 405728, 427495, 449094, 470527, 491847, 513074}, // 1944
+	{7234, 28433, 49699, 71054, 92558, 114217, 136071, 158107, 180336, 202720, 225245, 247852, 270506, 293145, 315725, 338195, 360518, 382669, 404629, 426403, 447994, 469435, 490747, 511983}, // 1945
+	{7576, 28784, 50043, 71408, 92904, 114572, 136418, 158462, 180681, 203073, 225588, 248204, 270850, 293497, 316071, 338546, 360867, 383020, 404980, 426754, 448347, 469786, 491100, 512333}, // 1946
+	{7926, 29131, 50390, 71751, 93248, 114912, 136760, 158799, 181023, 203409, 225931, 248538, 271195, 293834, 316420, 338888, 361221, 383368, 405337, 427105, 448704, 470137, 491456, 512682}, // 1947
+	{8280, 29478, 50742, 72096, 93598, 115256, 137109, 159145, 181372, 203757, 226280, 248890, 271543, 294187, 316766, 339242, 361565, 383721, 405680, 427457, 449046, 470488, 491797, 513033}, // 1948
+	{7181, 28388, 49642, 71007, 92499, 114168, 136012, 158057, 180276, 202670, 225186, 247802, 270451, 293096, 315675, 338148, 360474, 382625, 404591, 426362, 447959, 469396, 490713, 511943}, // 1949
+	{7538, 28739, 50000, 71357, 92855, 114515, 136364, 158399, 180624, 203007, 225531, 248136, 270793, 293429, 316015, 338483, 360813, 382963, 404931, 426704, 448303, 469742, 491061, 512293}, // 1950
+	{7890, 29092, 50353, 71709, 93206, 114865, 136712, 158748, 180969, 203355, 225872, 248484, 271133, 293780, 316357, 338836, 361158, 383316, 405276, 427056, 448646, 470091, 491402, 512640}, // 1951
+	{8229, 29438, 50693, 72056, 93547, 115213, 137055, 159096, 181314, 203704, 226220, 248832, 271484, 294127, 316711, 339182, 361513, 383663, 405632, 427402, 449001, 470435, 491755, 512983}, // 1952
+	{7142, 28341, 49606, 70961, 92462, 114120, 135972, 158005, 180232, 202612, 225136, 247740, 270395, 293032, 315614, 338085, 360412, 382565, 404530, 426306, 447900, 469342, 490656, 511891}, // 1953
+	{7485, 28691, 49950, 71312, 92808, 114473, 136319, 158359, 180578, 202967, 225480, 248094, 270739, 293385, 315959, 338436, 360757, 382915, 404877, 426656, 448250, 469694, 491008, 512244}, // 1954
+	{7836, 29042, 50297, 71658, 93151, 114815, 136658, 158698, 180918, 203304, 225823, 248431, 271085, 293724, 316310, 338778, 361111, 383260, 405232, 427003, 448605, 470040, 491362, 512590}, // 1955
+	{8190, 29388, 50652, 72004, 93504, 115160, 137011, 159043, 181270, 203652, 226175, 248783, 271438, 294079, 316660, 339134, 361459, 383615, 405576, 427354, 448946, 470389, 491702, 512939}, // 1956
+	{7090, 28298, 49554, 70918, 92410, 114076, 135918, 157961, 180178, 202570, 225084, 247700, 270348, 292994, 315572, 338047, 360372, 382526, 404490, 426264, 447860, 469299, 490616, 511848}, // 1957
+	{7444, 28648, 49909, 71268, 92764, 114425, 136272, 158307, 180529, 202911, 225432, 248036, 270693, 293330, 315917, 338385, 360718, 382868, 404839, 426611, 448211, 469649, 490969, 512199}, // 1958
+	{7798, 28998, 50262, 71617, 93116, 114774, 136623, 158656, 180878, 203262, 225780, 248389, 271039, 293685, 316264, 338743, 361067, 383228, 405189, 426970, 448562, 470006, 491317, 512554}, // 1959
+	{8142, 29350, 50603, 71966, 93456, 115122, 136963, 159006, 181222, 203613, 226128, 248742, 271392, 294037, 316619, 339094, 361425, 383578, 405548, 427321, 448922, 470358, 491677, 512905}, // 1960
+	{7062, 28261, 49522, 70876, 92374, 114032, 135882, 157915, 180141, 202522, 225046, 247650, 270306, 292943, 315528, 337998, 360329, 382482, 404450, 426227, 447826, 469267, 490585, 511819}, // 1961
+	{7414, 28617, 49877, 71234, 92729, 114389, 136234, 158270, 180489, 202876, 225391, 248004, 270651, 293297, 315873, 338352, 360675, 382835, 404797, 426580, 448174, 469621, 490936, 512175}, // 1962
+	{7766, 28973, 50227, 71588, 93077, 114739, 136578, 158616, 180832, 203218, 225734, 248344, 270997, 293639, 316225, 338697, 361031, 383183, 405156, 426928, 448532, 469969, 491292, 512521}, // 1963
+	{8122, 29321, 50585, 71937, 93436, 115089, 136938, 158967, 181191, 203569, 226091, 248696, 271352, 293992, 316576, 339051, 361379, 383536, 405501, 427280, 448875, 470318, 491633, 512869}, // 1964
+	{7022, 28228, 49486, 70847, 92340, 114004, 135846, 157886, 180101, 202490, 225002, 247615, 270261, 292908, 315484, 337962, 360287, 382446, 404411, 426189, 447786, 469229, 490545, 511780}, // 1965
+	{7374, 28579, 49837, 71197, 92691, 114352, 136196, 158231, 180450, 202832, 225349, 247953, 270607, 293243, 315828, 338297, 360631, 382783, 404756, 426530, 448135, 469574, 490897, 512128}, // 1966
+	{7728, 28927, 50190, 71543, 93042, 114696, 136544, 158575, 180797, 203178, 225696, 248302, 270953, 293595, 316174, 338652, 360977, 383137, 405101, 426883, 448477, 469924, 491237, 512476}, // 1967
+	{8066, 29274, 50527, 71889, 93377, 115042, 136880, 158921, 181135, 203526, 226039, 248653, 271301, 293947, 316527, 339002, 361331, 383486, 405454, 427229, 448829, 470268, 491588, 512819}, // 1968
+	{6976, 28178, 49439, 70794, 92290, 113948, 135794, 157827, 180049, 202429, 224951, 247555, 270211, 292848, 315434, 337903, 360235, 382386, 404356, 426131, 447731, 469171, 490491, 511723}, // 1969
+	{7321, 28523, 49785, 71141, 92638, 114296, 136141, 158174, 180393, 202777, 225292, 247902, 270550, 293196, 315774, 338253, 360577, 382739, 404701, 426484, 448077, 469524, 490837, 512075}, // 1970
+	{7665, 28872, 50125, 71487, 92974, 114638, 136476, 158514, 180728, 203114, 225628, 248239, 270891, 293534, 316120, 338595, 360930, 383084, 405058, 426833, 448436, 469873, 491195, 512423}, // 1971
+	{8021, 29218, 50480, 71831, 93328, 114981, 136828, 158857, 181081, 203459, 225982, 248586, 271242, 293882, 316468, 338943, 361275, 383432, 405401, 427181, 448779, 470222, 491538, 512772}, // 1972
+	{6925, 28128, 49384, 70741, 92232, 113892, 135733, 157770, 179986, 202373, 224887, 247500, 270147, 292795, 315372, 337853, 360179, 382341, 404307, 426090, 447687, 469133, 490450, 511687}, // 1973
+	{7280, 28485, 49740, 71098, 92587, 114246, 136085, 158118, 180334, 202716, 225231, 247837, 270491, 293130, 315717, 338188, 360525, 382678, 404654, 426430, 448038, 469478, 490804, 512035}, // 1974
+	{7637, 28836, 50099, 71449, 92945, 114596, 136441, 158467, 180687, 203063, 225582, 248186, 270839, 293481, 316064, 338543, 360873, 383035, 405002, 426786, 448382, 469830, 491146, 512385}, // 1975
+	{7977, 29185, 50439, 71800, 93288, 114949, 136786, 158823, 181034, 203421, 225931, 248544, 271190, 293838, 316418, 338898, 361228, 383388, 405358, 427138, 448738, 470181, 491500, 512735}, // 1976
+	{6891, 28094, 49353, 70710, 92204, 113862, 135705, 157737, 179956, 202334, 224852, 247453, 270107, 292743, 315330, 337800, 360135, 382289, 404263, 426040, 447645, 469086, 490410, 511643}, // 1977
+	{7243, 28444, 49707, 71061, 92558, 114213, 136059, 158089, 180308, 202688, 225203, 247809, 270457, 293100, 315677, 338156, 360482, 382645, 404610, 426397, 447994, 469444, 490760, 512000}, // 1978
+	{7591, 28800, 50052, 71413, 92899, 114561, 136398, 158435, 180647, 203033, 225545, 248156, 270804, 293448, 316030, 338506, 360839, 382996, 404970, 426747, 448352, 469794, 491117, 512349}, // 1979
+	{7948, 29148, 50409, 71761, 93256, 114909, 136754, 158782, 181004, 203382, 225903, 248507, 271164, 293802, 316388, 338860, 361193, 383348, 405319, 427097, 448698, 470141, 491461, 512696}, // 1980
+	{6852, 28056, 49315, 70671, 92165, 113822, 135665, 157698, 179914, 202299, 224812, 247424, 270072, 292719, 315297, 337778, 360103, 382265, 404229, 426012, 447608, 469055, 490371, 511610}, // 1981
+	{7202, 28410, 49665, 71026, 92514, 114175, 136012, 158047, 180260, 202643, 225156, 247763, 270414, 293055, 315641, 338115, 360451, 382606, 404582, 426357, 447964, 469403, 490728, 511958}, // 1982
+	{7558, 28757, 50019, 71370, 92867, 114518, 136364, 158390, 180610, 202986, 225505, 248108, 270763, 293404, 315989, 338467, 360799, 382961, 404931, 426714, 448312, 469758, 491073, 512309}, // 1983
+	{7900, 29105, 50358, 71716, 93204, 114864, 136702, 158738, 180951, 203337, 225848, 248462, 271109, 293758, 316337, 338820, 361149, 383312, 405282, 427065, 448665, 470110, 491427, 512662}, // 1984
+	{6815, 28017, 49271, 70627, 92116, 113773, 135613, 157645, 179862, 202242, 224760, 247364, 270018, 292656, 315244, 337715, 360052, 382207, 404184, 425961, 447569, 469010, 490336, 511567}, // 1985
+	{7168, 28366, 49627, 70977, 92472, 114122, 135966, 157992, 180210, 202587, 225104, 247710, 270360, 293004, 315585, 338065, 360394, 382558, 404526, 426314, 447912, 469364, 490680, 511922}, // 1986
+	{7513, 28720, 49971, 71330, 92813, 114472, 136304, 158337, 180545, 202930, 225439, 248050, 270698, 293346, 315929, 338409, 360744, 382905, 404879, 426660, 448265, 469709, 491032, 512265}, // 1987
+	{7863, 29064, 50323, 71675, 93166, 114818, 136659, 158685, 180901, 203276, 225795, 248396, 271052, 293691, 316280, 338753, 361091, 383248, 405224, 427004, 448608, 470052, 491374, 512607}, // 1988
+	{6765, 27967, 49227, 70580, 92074, 113728, 135570, 157599, 179814, 202193, 224705, 247313, 269959, 292605, 315183, 337666, 359993, 382159, 404127, 425915, 447513, 468964, 490280, 511522}, // 1989
+	{7113, 28321, 49574, 70934, 92419, 114079, 135913, 157946, 180155, 202537, 225046, 247652, 270300, 292941, 315525, 338000, 360337, 382495, 404473, 426253, 447863, 469306, 490634, 511866}, // 1990
+	{7468, 28667, 49928, 71278, 92772, 114421, 136264, 158288, 180507, 202880, 225398, 247998, 270652, 293291, 315877, 338352, 360687, 382847, 404821, 426605, 448207, 469655, 490976, 512213}, // 1991
+	{7808, 29012, 50268, 71623, 93112, 114768, 136605, 158637, 180848, 203232, 225742, 248354, 271000, 293648, 316227, 338709, 361038, 383202, 405171, 426956, 448556, 470005, 491324, 512563}, // 1992
+	{6716, 27922, 49177, 70535, 92022, 113680, 135517, 157549, 179761, 202141, 224655, 247259, 269912, 292550, 315137, 337610, 359947, 382102, 404079, 425857, 447465, 468906, 490233, 511465}, // 1993
+	{7068, 28267, 49530, 70881, 92377, 114028, 135871, 157896, 180114, 202488, 225004, 247607, 270259, 292900, 315484, 337963, 360295, 382459, 404429, 426215, 447815, 469265, 490582, 511822}, // 1994
+	{7414, 28620, 49872, 71230, 92716, 114374, 136208, 158241, 180450, 202834, 225342, 247954, 270600, 293249, 315831, 338314, 360648, 382812, 404787, 426571, 448175, 469621, 490942, 512176}, // 1995
+	{7771, 28972, 50227, 71580, 93069, 114723, 136562, 158589, 180806, 203183, 225700, 248303, 270959, 293598, 316188, 338662, 361002, 383159, 405138, 426918, 448526, 469969, 491293, 512525}, // 1996
+	{6684, 27882, 49141, 70491, 91984, 113634, 135476, 157502, 179719, 202098, 224612, 247220, 269869, 292515, 315096, 337579, 359908, 382075, 404045, 425834, 447434, 468887, 490204, 511447}, // 1997
+	{7038, 28246, 49496, 70854, 92337, 113994, 135825, 157856, 180063, 202445, 224953, 247562, 270210, 292855, 315439, 337918, 360255, 382417, 404395, 426178, 447788, 469234, 490561, 511796}, // 1998
+	{7397, 28597, 49857, 71206, 92697, 114345, 136184, 158206, 180421, 202792, 225309, 247909, 270565, 293204, 315794, 338270, 360609, 382771, 404748, 426532, 448137, 469584, 490907, 512143}, // 1999
+	{7740, 28943, 50200, 71553, 93042, 114695, 136532, 158559, 180770, 203149, 225658, 248267, 270914, 293562, 316143, 338628, 360959, 383127, 405098, 426887, 448487, 469939, 491257, 512497}, // 2000
+	{6649, 27856, 49108, 70467, 91952, 113610, 135444, 157476, 179685, 202064, 224573, 247177, 269826, 292466, 315052, 337527, 359866, 382024, 404004, 425785, 447396, 468840, 490168, 511401}, // 2001
+	{7003, 28202, 49464, 70813, 92307, 113956, 135798, 157820, 180037, 202409, 224924, 247524, 270176, 292814, 315399, 337876, 360211, 382375, 404349, 426137, 447741, 469193, 490514, 511754}, // 2002
+	{7347, 28552, 49805, 71160, 92645, 114299, 136132, 158162, 180370, 202752, 225259, 247870, 270515, 293164, 315744, 338228, 360560, 382726, 404700, 426488, 448093, 469543, 490865, 512103}, // 2003
+	{7698, 28902, 50156, 71510, 92995, 114648, 136483, 158510, 180722, 203099, 225613, 248216, 270871, 293510, 316099, 338573, 360912, 383069, 405049, 426828, 448438, 469881, 491208, 512441}, // 2004
+	{6602, 27801, 49063, 70412, 91905, 113553, 135394, 157417, 179632, 202007, 224521, 247126, 269776, 292420, 315003, 337485, 359816, 381983, 403953, 425742, 447342, 468794, 490112, 511354}, // 2005
+	{6946, 28155, 49407, 70765, 92248, 113905, 135735, 157766, 179970, 202351, 224857, 247465, 270111, 292757, 315340, 337822, 360158, 382323, 404301, 426086, 447694, 469141, 490466, 511702}, // 2006
+	{7300, 28500, 49758, 71108, 92598, 114247, 136084, 158107, 180320, 202692, 225207, 247806, 270461, 293100, 315691, 338167, 360509, 382671, 404651, 426435, 448044, 469489, 490814, 512047}, // 2007
+	{7644, 28843, 50100, 71449, 92938, 114588, 136425, 158451, 180663, 203041, 225551, 248159, 270806, 293454, 316036, 338522, 360854, 383024, 404996, 426788, 448390, 469844, 491162, 512403}, // 2008
+	{6554, 27760, 49009, 70366, 91847, 113503, 135333, 157364, 179570, 201951, 224459, 247065, 269713, 292355, 314941, 337418, 359757, 381918, 403900, 425683, 447296, 468742, 490072, 511306}, // 2009
+	{6908, 28107, 49367, 70715, 92206, 113852, 135690, 157709, 179924, 202293, 224809, 247408, 270062, 292701, 315289, 337766, 360104, 382269, 404246, 426035, 447642, 469094, 490418, 511658}, // 2010
+	{7254, 28458, 49713, 71065, 92549, 114200, 136031, 158057, 180263, 202641, 225147, 247756, 270401, 293051, 315633, 338120, 360454, 382624, 404599, 426390, 447994, 469447, 490769, 512010}, // 2011
+	{7603, 28809, 50062, 71417, 92901, 114554, 136385, 158412, 180619, 202995, 225506, 248108, 270760, 293400, 315990, 338466, 360808, 382968, 404951, 426733, 448345, 469790, 491118, 512351}, // 2012
+	{6513, 27711, 48973, 70321, 91814, 113461, 135302, 157323, 179538, 201909, 224423, 247024, 269674, 292316, 314900, 337381, 359716, 381884, 403858, 425649, 447253, 468708, 490028, 511271}, // 2013
+	{6864, 28071, 49323, 70679, 92162, 113817, 135646, 157675, 179879, 202259, 224763, 247371, 270014, 292661, 315242, 337726, 360061, 382229, 404207, 425997, 447606, 469058, 490384, 511623}, // 2014
+	{7220, 28423, 49678, 71029, 92515, 114165, 135999, 158021, 180232, 202604, 225118, 247717, 270372, 293010, 315601, 338077, 360419, 382580, 404562, 426346, 447958, 469405, 490733, 511967}, // 2015
+	{7568, 28767, 50026, 71373, 92863, 114510, 136347, 158369, 180582, 202956, 225468, 248074, 270723, 293370, 315953, 338438, 360771, 382941, 404913, 426705, 448307, 469762, 491081, 512324}, // 2016
+	{6475, 27683, 48934, 70291, 91772, 113428, 135257, 157286, 179491, 201870, 224376, 246984, 269630, 292275, 314859, 337340, 359678, 381841, 403822, 425606, 447217, 468664, 489992, 511227}, // 2017
+	{6828, 28029, 49288, 70638, 92128, 113775, 135612, 157632, 179845, 202214, 224729, 247327, 269981, 292620, 315210, 337688, 360029, 382194, 404174, 425962, 447571, 469021, 490345, 511582}, // 2018
+	{7178, 28379, 49634, 70983, 92469, 114118, 135951, 157975, 180182, 202559, 225066, 247674, 270320, 292970, 315553, 338041, 360376, 382550, 404525, 426319, 447924, 469378, 490698, 511939}, // 2019
+	{7530, 28734, 49983, 71337, 92816, 114469, 136298, 158325, 180531, 202909, 225418, 248023, 270674, 293316, 315906, 338384, 360727, 382890, 404875, 426659, 448273, 469719, 491049, 512282}, // 2020
+	{6443, 27639, 48898, 70243, 91733, 113377, 135215, 157233, 179447, 201817, 224332, 246932, 269585, 292226, 314813, 337294, 359632, 381801, 403778, 425571, 447178, 468633, 489957, 511199}, // 2021
+	{6794, 27999, 49250, 70603, 92083, 113733, 135560, 157584, 179785, 202162, 224665, 247273, 269917, 292566, 315149, 337636, 359972, 382143, 404122, 425915, 447525, 468980, 490306, 511548}, // 2022
+	{7144, 28349, 49602, 70954, 92436, 114084, 135913, 157933, 180138, 202509, 225018, 247617, 270270, 292910, 315502, 337981, 360326, 382489, 404475, 426260, 447875, 469322, 490652, 511887}, // 2023
+	{7489, 28687, 49947, 71293, 92782, 114426, 136262, 158279, 180490, 202859, 225369, 247970, 270619, 293264, 315849, 338334, 360671, 382843, 404819, 426614, 448219, 469676, 490996, 512240}, // 2024
+	{6392, 27600, 48850, 70206, 91687, 113341, 135168, 157195, 179397, 201774, 224276, 246882, 269524, 292169, 314751, 337233, 359571, 381739, 403721, 425510, 447123, 468575, 489904, 511142}, // 2025
+	{6743, 27944, 49202, 70551, 92038, 113685, 135519, 157539, 179748, 202116, 224628, 247224, 269876, 292512, 315102, 337578, 359921, 382085, 404069, 425857, 447471, 468923, 490252, 511490}, // 2026
+	{7089, 28289, 49546, 70893, 92379, 114024, 135857, 157877, 180085, 202458, 224965, 247570, 270217, 292864, 315446, 337934, 360268, 382441, 404416, 426212, 447818, 469276, 490597, 511842}, // 2027
+	{7434, 28641, 49891, 71246, 92724, 114377, 136202, 158229, 180432, 202809, 225315, 247921, 270570, 293213, 315801, 338280, 360621, 382785, 404768, 426553, 448167, 469614, 490944, 512179}, // 2028
+	{6341, 27540, 48800, 70147, 91637, 113281, 135118, 157135, 179347, 201715, 224229, 246828, 269482, 292122, 314711, 337191, 359531, 381698, 403677, 425467, 447076, 468529, 489853, 511093}, // 2029
+	{6690, 27894, 49148, 70499, 91983, 113631, 135460, 157483, 179686, 202061, 224564, 247171, 269815, 292464, 315047, 337536, 359872, 382046, 404025, 425820, 447428, 468884, 490207, 511449}, // 2030
+	{7043, 28247, 49498, 70850, 92330, 113980, 135808, 157831, 180035, 202407, 224915, 247516, 270168, 292810, 315402, 337883, 360229, 382395, 404382, 426169, 447785, 469232, 490562, 511795}, // 2031
+	{7395, 28591, 49848, 71192, 92680, 114321, 136157, 158173, 180385, 202754, 225267, 247868, 270520, 293164, 315752, 338238, 360577, 382750, 404730, 426525, 448133, 469590, 490913, 512155}, // 2032
+	{6307, 27512, 48761, 70113, 91592, 113242, 135068, 157092, 179293, 201670, 224173, 246780, 269424, 292072, 314655, 337141, 359480, 381651, 403633, 425427, 447040, 468495, 489824, 511065}, // 2033
+	{6664, 27867, 49121, 70470, 91952, 113597, 135426, 157443, 179649, 202016, 224526, 247124, 269777, 292416, 315008, 337487, 359833, 381999, 403986, 425776, 447393, 468844, 490176, 511413}, // 2034
+	{7015, 28214, 49471, 70816, 92301, 113942, 135773, 157788, 179994, 202363, 224870, 247472, 270120, 292768, 315354, 337843, 360182, 382358, 404337, 426136, 447743, 469203, 490525, 511770}, // 2035
+	{7363, 28570, 49819, 71174, 92651, 114302, 136126, 158150, 180349, 202724, 225226, 247831, 270477, 293122, 315708, 338192, 360534, 382703, 404688, 426478, 448094, 469545, 490875, 512112}, // 2036
+	{6273, 27473, 48731, 70078, 91565, 113209, 135043, 157060, 179269, 201635, 224146, 246742, 269394, 292032, 314622, 337101, 359445, 381612, 403597, 425389, 447003, 468458, 489787, 511027}, // 2037
+	{6626, 27828, 49083, 70431, 91915, 113560, 135389, 157408, 179610, 201982, 224485, 247089, 269732, 292379, 314961, 337449, 359786, 381961, 403941, 425740, 447350, 468811, 490136, 511382}, // 2038
+	{6976, 28183, 49432, 70785, 92262, 113911, 135735, 157757, 179957, 202330, 224835, 247437, 270085, 292727, 315317, 337798, 360143, 382309, 404297, 426084, 447702, 469151, 490484, 511720}, // 2039
+	{7323, 28520, 49779, 71123, 92610, 114251, 136085, 158099, 180309, 202675, 225187, 247786, 270439, 293080, 315669, 338153, 360493, 382664, 404645, 426439, 448049, 469505, 490829, 512072}, // 2040
+	{6227, 27433, 48684, 70037, 91517, 113166, 134992, 157014, 179214, 201588, 224089, 246695, 269338, 291986, 314568, 337056, 359393, 381566, 403546, 425341, 446952, 468408, 489735, 510978}, // 2041
+	{6574, 27779, 49032, 70384, 91865, 113513, 135340, 157359, 179562, 201931, 224437, 247035, 269687, 292326, 314918, 337397, 359745, 381911, 403900, 425689, 447307, 468757, 490088, 511323}, // 2042
+	{6925, 28121, 49378, 70721, 92207, 113847, 135679, 157694, 179901, 202268, 224777, 247378, 270027, 292673, 315260, 337749, 360089, 382266, 404247, 426046, 447655, 469114, 490437, 511680}, // 2043
+	{7272, 28477, 49724, 71075, 92551, 114200, 136022, 158046, 180245, 202621, 225123, 247730, 270375, 293023, 315608, 338094, 360436, 382607, 404592, 426386, 448001, 469455, 490784, 512023}, // 2044
+	{6182, 27381, 48636, 69982, 91464, 113107, 134937, 156952, 179159, 201525, 224036, 246633, 269287, 291926, 314519, 336998, 359345, 381512, 403500, 425292, 446909, 468363, 489695, 510934}, // 2045
+	{6535, 27735, 48990, 70335, 91817, 113457, 135284, 157298, 179500, 201868, 224372, 246974, 269620, 292268, 314853, 337344, 359683, 381861, 403842, 425643, 447253, 468716, 490041, 511288}, // 2046
+	{6882, 28089, 49337, 70690, 92165, 113812, 135632, 157652, 179848, 202219, 224720, 247323, 269970, 292615, 315205, 337690, 360037, 382207, 404197, 425988, 447607, 469058, 490390, 511627}, // 2047
+	{7229, 28426, 49684, 71028, 92513, 114153, 135985, 157997, 180204, 202567, 225078, 247673, 270326, 292966, 315558, 338042, 360387, 382560, 404546, 426342, 447956, 469413, 490740, 511981}, // 2048
+	{6138, 27341, 48593, 69942, 91422, 113068, 134894, 156913, 179112, 201483, 223983, 246587, 269228, 291876, 314457, 336947, 359285, 381462, 403444, 425244, 446858, 468319, 489646, 510891}, // 2049
+	{6487, 27693, 48943, 70294, 91772, 113419, 135242, 157261, 179461, 201830, 224334, 246932, 269581, 292221, 314812, 337292, 359640, 381808, 403799, 425591, 447213, 468665, 490001, 511238}, // 2050
+	{6841, 28038, 49295, 70637, 92121, 113758, 135589, 157600, 179806, 202171, 224680, 247278, 269929, 292572, 315161, 337648, 359991, 382167, 404150, 425949, 447561, 469022, 490348, 511593}, // 2051
+	{7188, 28393, 49642, 70993, 92469, 114115, 135937, 157957, 180154, 202528, 225029, 247635, 270279, 292928, 315512, 338001, 360341, 382515, 404499, 426294, 447909, 469365, 490695, 511936}, // 2052
+	{6095, 27298, 48552, 69901, 91382, 113027, 134854, 156869, 179073, 201439, 223947, 246543, 269196, 291835, 314429, 336909, 359258, 381425, 403415, 425206, 446825, 468278, 489611, 510849}, // 2053
+	{6452, 27650, 48907, 70251, 91735, 113374, 135202, 157214, 179417, 201782, 224287, 246886, 269533, 292180, 314766, 337258, 359599, 381779, 403761, 425564, 447175, 468638, 489963, 511209}, // 2054
+	{6802, 28008, 49255, 70607, 92081, 113728, 135547, 157568, 179763, 202135, 224635, 247239, 269885, 292531, 315120, 337608, 359955, 382128, 404118, 425913, 447532, 468985, 490318, 511555}, // 2055
+	{7155, 28352, 49606, 70949, 92431, 114070, 135899, 157911, 180117, 202481, 224992, 247587, 270242, 292881, 315475, 337958, 360306, 382479, 404468, 426265, 447882, 469339, 490670, 511911}, // 2056
+	{6069, 27269, 48522, 69867, 91346, 112987, 134812, 156827, 179026, 201395, 223896, 246498, 269142, 291790, 314373, 336864, 359203, 381383, 403365, 425168, 446782, 468246, 489574, 510822}, // 2057
+	{6418, 27625, 48874, 70225, 91699, 113344, 135163, 157180, 179375, 201743, 224244, 246843, 269491, 292133, 314724, 337208, 359557, 381728, 403720, 425514, 447136, 468590, 489926, 511164}, // 2058
+	{6768, 27966, 49223, 70564, 92048, 113684, 135512, 157520, 179723, 202084, 224592, 247187, 269838, 292480, 315072, 337559, 359906, 382083, 404070, 425870, 447485, 468945, 490273, 511517}, // 2059
+	{7113, 28317, 49568, 70917, 92393, 114038, 135859, 157877, 180072, 202443, 224941, 247545, 270187, 292835, 315418, 337909, 360250, 382427, 404413, 426213, 447828, 469288, 490617, 511861}, // 2060
+	{6018, 27222, 48473, 69822, 91301, 112945, 134770, 156786, 178986, 201352, 223856, 246452, 269101, 291740, 314332, 336812, 359162, 381331, 403323, 425116, 446739, 468193, 489530, 510768}, // 2061
+	{6372, 27569, 48826, 70167, 91651, 113287, 135115, 157124, 179327, 201689, 224194, 246791, 269438, 292081, 314668, 337157, 359500, 381679, 403664, 425467, 447082, 468546, 489874, 511122}, // 2062
+	{6716, 27923, 49170, 70521, 91994, 113638, 135456, 157474, 179668, 202039, 224537, 247141, 269785, 292433, 315019, 337508, 359853, 382027, 404016, 425812, 447431, 468887, 490220, 511460}, // 2063
+	{7060, 28261, 49514, 70859, 92339, 113978, 135804, 157815, 180018, 202381, 224889, 247485, 270139, 292779, 315373, 337856, 360206, 382376, 404367, 426161, 447781, 469236, 490568, 511808}, // 2064
+	{5969, 27168, 48423, 69767, 91248, 112887, 134713, 156725, 178925, 201290, 223791, 246392, 269036, 291684, 314268, 336761, 359101, 381282, 403265, 425069, 446682, 468146, 489472, 510720}, // 2065
+	{6314, 27521, 48769, 70120, 91593, 113239, 135057, 157075, 179268, 201637, 224135, 246736, 269381, 292025, 314616, 337103, 359453, 381626, 403620, 425416, 447038, 468493, 489828, 511065}, // 2066
+	{6666, 27862, 49117, 70457, 91938, 113573, 135400, 157408, 179611, 201972, 224481, 247075, 269728, 292370, 314964, 337451, 359801, 381979, 403970, 425771, 447390, 468850, 490180, 511422}, // 2067
+	{7019, 28219, 49468, 70813, 92288, 113928, 135749, 157764, 179960, 202329, 224829, 247433, 270076, 292726, 315310, 337803, 360145, 382326, 404312, 426116, 447733, 469196, 490525, 511772}, // 2068
+	{5928, 27132, 48380, 69728, 91202, 112844, 134663, 156678, 178874, 201240, 223743, 246341, 268990, 291632, 314225, 336708, 359060, 381231, 403226, 425021, 446647, 468103, 489441, 510681}, // 2069
+	{6287, 27484, 48741, 70080, 91562, 113194, 135019, 157024, 179224, 201583, 224087, 246682, 269331, 291975, 314566, 337056, 359403, 381584, 403573, 425378, 446995, 468460, 489790, 511039}, // 2070
+	{6635, 27842, 49090, 70439, 91912, 113554, 135370, 157384, 179574, 201942, 224437, 247040, 269682, 292331, 314918, 337411, 359757, 381937, 403927, 425728, 447348, 468808, 490140, 511383}, // 2071
+	{6982, 28184, 49436, 70782, 92260, 113900, 135723, 157734, 179933, 202295, 224799, 247393, 270044, 292683, 315278, 337762, 360114, 382287, 404282, 426079, 447703, 469159, 490495, 511735}, // 2072
+	{5898, 27096, 48352, 69694, 91176, 112812, 134638, 156647, 178847, 201209, 223710, 246306, 268950, 291594, 314179, 336670, 359012, 381194, 403180, 424987, 446603, 468070, 489399, 510650}, // 2073
+	{6245, 27453, 48700, 70051, 91523, 113168, 134984, 157001, 179192, 201561, 224057, 246658, 269300, 291945, 314532, 337020, 359367, 381543, 403536, 425335, 446959, 468417, 489753, 510994}, // 2074
+	{6597, 27796, 49050, 70391, 91870, 113506, 135330, 157338, 179539, 201899, 224406, 247000, 269653, 292293, 314887, 337372, 359723, 381898, 403891, 425690, 447311, 468770, 490103, 511346}, // 2075
+	{6946, 28147, 49399, 70743, 92220, 113858, 135679, 157691, 179888, 202254, 224754, 247356, 270000, 292649, 315234, 337727, 360068, 382249, 404234, 426038, 447653, 469117, 490445, 511692}, // 2076
+	{5848, 27054, 48302, 69653, 91126, 112770, 134588, 156604, 178797, 201164, 223664, 246263, 268910, 291553, 314146, 336631, 358982, 381155, 403150, 424945, 446569, 468024, 489362, 510600}, // 2077
+	{6204, 27400, 48656, 69996, 91477, 113110, 134935, 156940, 179141, 201499, 224004, 246597, 269248, 291890, 314483, 336973, 359323, 381504, 403495, 425300, 446918, 468382, 489712, 510957}, // 2078
+	{6553, 27755, 49002, 70347, 91820, 113460, 135276, 157290, 179481, 201849, 224345, 246948, 269591, 292242, 314828, 337323, 359669, 381852, 403842, 425647, 447266, 468729, 490059, 511303}, // 2079
+	{6899, 28100, 49347, 70692, 92164, 113803, 135622, 157634, 179830, 202194, 224697, 247293, 269945, 292586, 315182, 337667, 360022, 382196, 404193, 425991, 447618, 469075, 490413, 511652}, // 2080
+	{5815, 27011, 48265, 69603, 91082, 112713, 134536, 156541, 178739, 201098, 223600, 246196, 268843, 291487, 314076, 336568, 358914, 381097, 403086, 424894, 446512, 467980, 489311, 510562}, // 2081
+	{6158, 27365, 48611, 69960, 91429, 113070, 134882, 156895, 179082, 201448, 223941, 246542, 269184, 291832, 314420, 336913, 359262, 381442, 403437, 425239, 446863, 468325, 489661, 510904}, // 2082
+	{6505, 27705, 48957, 70299, 91775, 113410, 135229, 157235, 179431, 201788, 224291, 246883, 269535, 292175, 314772, 337258, 359614, 381791, 403789, 425590, 447215, 468675, 490011, 511252}, // 2083
+	{6854, 28053, 49306, 70647, 92124, 113759, 135580, 157587, 179782, 202144, 224642, 247240, 269883, 292530, 315115, 337610, 359953, 382138, 404126, 425935, 447553, 469021, 490350, 511600}, // 2084
+	{5755, 26963, 48209, 69559, 91030, 112673, 134487, 156502, 178692, 201058, 223554, 246152, 268795, 291439, 314029, 336516, 358867, 381043, 403040, 424839, 446467, 467926, 489266, 510508}, // 2085
+	{6113, 27311, 48565, 69904, 91383, 113014, 134837, 156840, 179038, 201394, 223898, 246489, 269139, 291779, 314373, 336860, 359212, 381391, 403386, 425191, 446815, 468280, 489615, 510862}, // 2086
+	{6462, 27664, 48914, 70258, 91731, 113367, 135184, 157193, 179384, 201748, 224244, 246845, 269487, 292137, 314724, 337219, 359564, 381748, 403737, 425543, 447162, 468628, 489959, 511208}, // 2087
+	{6804, 28010, 49257, 70604, 92076, 113716, 135532, 157543, 179736, 202099, 224599, 247196, 269845, 292487, 315083, 337569, 359923, 382098, 404096, 425893, 447520, 468977, 490316, 511555}, // 2088
+	{5720, 26917, 48174, 69513, 90994, 112626, 134450, 156453, 178651, 201007, 223510, 246102, 268750, 291393, 313984, 336475, 358823, 381006, 402997, 424805, 446424, 467891, 489222, 510471}, // 2089
+	{6068, 27274, 48521, 69869, 91341, 112981, 134795, 156808, 178996, 201361, 223854, 246455, 269096, 291745, 314332, 336826, 359175, 381359, 403353, 425158, 446782, 468245, 489579, 510823}, // 2090
+	{6421, 27621, 48870, 70212, 91685, 113321, 135139, 157147, 179342, 201702, 224205, 246798, 269450, 292091, 314689, 337175, 359532, 381710, 403710, 425512, 447140, 468599, 489938, 511178}, // 2091
+	{6780, 27976, 49228, 70565, 92042, 113673, 135494, 157499, 179695, 202056, 224557, 247154, 269800, 292447, 315035, 337529, 359875, 382061, 404051, 425861, 447480, 468950, 490280, 511531}, // 2092
+	{5686, 26893, 48138, 69485, 90954, 112594, 134405, 156418, 178605, 200971, 223466, 246066, 268710, 291357, 313947, 336438, 358789, 380968, 402965, 424768, 446395, 467857, 489196, 510440}, // 2093
+	{6044, 27243, 48496, 69835, 91311, 112941, 134759, 156760, 178955, 201309, 223811, 246402, 269053, 291694, 314291, 336779, 359135, 381316, 403314, 425119, 446746, 468210, 489547, 510793}, // 2094
+	{6394, 27595, 48846, 70187, 91661, 113294, 135110, 157115, 179305, 201665, 224160, 246758, 269400, 292050, 314638, 337136, 359483, 381670, 403662, 425472, 447092, 468560, 489891, 511140}, // 2095
+	{6735, 27941, 49186, 70533, 92002, 113642, 135455, 157466, 179655, 202018, 224514, 247110, 269756, 292399, 314993, 337481, 359836, 382014, 404015, 425815, 447445, 468904, 490245, 511485}, // 2096
+	{5650, 26846, 48101, 69439, 90917, 112547, 134369, 156370, 178567, 200921, 223423, 246012, 268660, 291300, 313892, 336381, 358732, 380915, 402910, 424719, 446343, 467812, 489147, 510396}, // 2097
+	{5995, 27200, 48448, 69792, 91263, 112900, 134712, 156721, 178908, 201271, 223763, 246362, 269001, 291650, 314236, 336731, 359078, 381263, 403257, 425066, 446690, 468157, 489492, 510740}, // 2098
+	{6338, 27541, 48789, 70132, 91602, 113237, 135051, 157057, 179248, 201607, 224107, 246701, 269351, 291992, 314589, 337076, 359433, 381610, 403611, 425412, 447042, 468502, 489842, 511083}, // 2099
+	{6688, 27885, 49139, 70476, 91954, 113583, 135403, 157404, 179600, 201956, 224457, 247051, 269698, 292343, 314933, 337427, 359774, 381960, 403950, 425760, 447379, 468848, 490179, 511430}, // 2100
+}
@@ -0,0 +1,118 @@
+package chinesecalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSolarTermTime(t *testing.T) {
+	equinox, err := GetSolarTermTime(2024, SpringEquinox)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 20, 11, 6, 0, 0, ChinaStandardTime), equinox)
+	solstice, err := GetSolarTermTime(2024, WinterSolstice)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 12, 21, 17, 20, 0, 0, ChinaStandardTime), solstice)
+
+	terms, err := GetSolarTerms(2024)
+	assert.NoError(t, err)
+	assert.Equal(t, 24, len(terms))
+	assert.Equal(t, time.Date(2024, 1, 6, 4, 49, 0, 0, ChinaStandardTime), terms[MinorCold])
+	assert.Equal(t, time.Date(2024, 2, 4, 16, 27, 0, 0, ChinaStandardTime), terms[StartOfSpring])
+	for i := 1; i < len(terms); i++ {
+		days := terms[i].Sub(terms[i-1]).Hours() / 24
+		assert.True(t, days > 14 && days < 16.5, terms[i])
+	}
+
+	_, err = GetSolarTermTime(1899, PureBrightness)
+	assert.Equal(t, ErrUnSupportSolarTermDate, err)
+	_, err = GetSolarTerms(2101)
+	assert.Equal(t, ErrUnSupportSolarTermDate, err)
+}
+
+func TestTombSweepingDaySolarTerm(t *testing.T) {
+	days := make(map[int][]Arrangement)
+	for _, a := range Default().Arrangements() {
+		if a.Holiday == TombSweepingDay && !a.Workday {
+			days[a.Date.Year()] = append(days[a.Date.Year()], a)
+		}
+	}
+
+	for year := 2004; year <= 2024; year++ {
+		term, err := GetSolarTermTime(year, PureBrightness)
+		assert.NoError(t, err)
+		day := civilDateOf(term)
+		assert.Equal(t, time.April, day.month, year)
+
+		// 2008 年起清明放假，法定假日为交节当天
+		if year < 2008 {
+			assert.Empty(t, days[year], year)
+			continue
+		}
+		var statutory []civilDate
+		for _, a := range days[year] {
+			if a.Statutory {
+				statutory = append(statutory, civilDateOf(a.Date))
+			}
+		}
+		assert.Equal(t, []civilDate{day}, statutory, year)
+	}
+
+	for year, day := range map[int]int{2004: 4, 2005: 5, 2006: 5, 2007: 5} {
+		term, _ := GetSolarTermTime(year, PureBrightness)
+		assert.Equal(t, day, term.Day(), year)
+	}
+}
+
+func TestGetSolarTerm(t *testing.T) {
+	st, day, err := GetSolarTerm(time.Date(2024, 4, 10, 23, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, PureBrightness, st)
+	assert.Equal(t, time.Date(2024, 4, 4, 0, 0, 0, 0, ChinaStandardTime), day)
+
+	// 小寒之前属于上一年的冬至
+	st, day, err = GetSolarTerm(time.Date(2024, 1, 3, 0, 0, 0, 0, ChinaStandardTime))
+	assert.NoError(t, err)
+	assert.Equal(t, WinterSolstice, st)
+	assert.Equal(t, time.Date(2023, 12, 22, 0, 0, 0, 0, ChinaStandardTime), day)
+
+	st, ok := IsSolarTermDay(time.Date(2024, 4, 4, 0, 0, 0, 0, ChinaStandardTime))
+	assert.True(t, ok)
+	assert.Equal(t, PureBrightness, st)
+	_, ok = IsSolarTermDay(time.Date(2024, 4, 5, 0, 0, 0, 0, ChinaStandardTime))
+	assert.False(t, ok)
+
+	_, _, err = GetSolarTerm(time.Date(1900, 1, 5, 0, 0, 0, 0, ChinaStandardTime))
+	assert.Equal(t, ErrUnSupportSolarTermDate, err)
+	st, day, err = GetSolarTerm(time.Date(2101, 1, 3, 0, 0, 0, 0, ChinaStandardTime))
+	assert.NoError(t, err)
+	assert.Equal(t, WinterSolstice, st)
+	assert.Equal(t, time.Date(2100, 12, 22, 0, 0, 0, 0, ChinaStandardTime), day)
+	// 2101 年没有数据，小寒之后的日期不能归入 2100 年的冬至
+	for _, d := range []time.Time{
+		time.Date(2101, 1, 5, 0, 0, 0, 0, ChinaStandardTime),
+		time.Date(2101, 8, 1, 0, 0, 0, 0, ChinaStandardTime),
+		time.Date(2101, 12, 31, 0, 0, 0, 0, ChinaStandardTime),
+		time.Date(2102, 1, 1, 0, 0, 0, 0, ChinaStandardTime),
+	} {
+		_, _, err = GetSolarTerm(d)
+		assert.Equal(t, ErrUnSupportSolarTermDate, err, d.String())
+	}
+}
+
+func TestMinWinterSolsticeGap(t *testing.T) {
+	for year := solarTermMinYear; year < solarTermMinYear+len(solarTermMinutes)-1; year++ {
+		gap := solarTermDay(year, WinterSolstice).daysUntil(solarTermDay(year+1, MinorCold))
+		assert.GreaterOrEqual(t, gap, minWinterSolsticeGap, year)
+	}
+}
+
+func TestSolarTermNames(t *testing.T) {
+	assert.Equal(t, "清明", PureBrightness.String())
+	assert.Equal(t, "Pure Brightness", PureBrightness.EngName())
+	assert.Equal(t, 15, PureBrightness.Longitude())
+	assert.Equal(t, 0, SpringEquinox.Longitude())
+	assert.Equal(t, 270, WinterSolstice.Longitude())
+	assert.Equal(t, "未知", SolarTerm(24).String())
+}
@@ -0,0 +1,192 @@
+package chinesecalendar
+
+import (
+	"math/bits"
+	"sort"
+	"time"
+)
+
+// dayBits 按年内序号（1 月 1 日为 0）每天一位
+type dayBits [6]uint64
+
+func (b *dayBits) has(i int) bool {
+	return b[i>>6]&(1<<uint(i&63)) != 0
+}
+
+func (b *dayBits) set(i int) {
+	b[i>>6] |= 1 << uint(i&63)
+}
+
+// count 返回 [from, to] 内被标记的天数
+func (b *dayBits) count(from, to int) int {
+	n := 0
+	for w := from >> 6; w <= to>>6; w++ {
+		n += bits.OnesCount64(b[w] & rangeMask(w, from, to))
+	}
+	return n
+}
+
+// each 按顺序对 [from, to] 内被标记的每一天调用 fn
+func (b *dayBits) each(from, to int, fn func(i int)) {
+	for w := from >> 6; w <= to>>6; w++ {
+		word := b[w] & rangeMask(w, from, to)
+		for word != 0 {
+			fn(w<<6 + bits.TrailingZeros64(word))
+			word &= word - 1
+		}
+	}
+}
+
+// rangeMask 返回第 w 个字中落在 [from, to] 内的位
+func rangeMask(w, from, to int) uint64 {
+	mask := ^uint64(0)
+	if w == from>>6 {
+		mask &= ^uint64(0) << uint(from&63)
+	}
+	if w == to>>6 {
+		mask &= ^uint64(0) >> uint(63-to&63)
+	}
+	return mask
+}
+
+// dayTag 年内 [first, last] 这段被标记的日期所属的节日
+type dayTag struct {
+	first, last int
+	holiday     Holiday
+}
+
+// yearTable 一年的节假日数据
+type yearTable struct {
+	year       int
+	holidays   dayBits
+	workdays   dayBits
+	inLieuDays dayBits
+	statutory  dayBits
+	// halfDays 只放假半天的工作日，见 Arrangement.HalfDay
+	halfDays dayBits
+	tags     []dayTag
+}
+
+// weekends 返回该年周六、周日对应的位
+func (tb *yearTable) weekends() *dayBits {
+	return &weekendBits[weekdayOf(tb.year, time.January, 1)]
+}
+
+// workdayBits 返回该年的工作日：调休上班日，以及不放假的周一至周五
+func (tb *yearTable) workdayBits() dayBits {
+	var b dayBits
+	weekends := tb.weekends()
+	for w := range b {
+		b[w] = tb.workdays[w] | ^(tb.holidays[w] | weekends[w])
+	}
+	return b
+}
+
+// restdayBits 返回该年的休息日，即非工作日
+func (tb *yearTable) restdayBits() dayBits {
+	b := tb.workdayBits()
+	for w := range b {
+		b[w] = ^b[w]
+	}
+	return b
+}
+
+func (tb *yearTable) isWorkday(i int) bool {
+	if tb.workdays.has(i) {
+		return true
+	}
+	return !tb.holidays.has(i) && !tb.weekends().has(i)
+}
+
+// holidayAt 返回第 i 天所属的节日（放假日、调休上班日及替代日都有标记）
+func (tb *yearTable) holidayAt(i int) (Holiday, bool) {
+	n := sort.Search(len(tb.tags), func(k int) bool { return tb.tags[k].last >= i })
+	if n < len(tb.tags) && tb.tags[n].first <= i {
+		return tb.tags[n].holiday, true
+	}
+	return Holiday{}, false
+}
+
+// weekendBits 按 1 月 1 日是星期几索引的周末位图
+var weekendBits = func() (masks [7]dayBits) {
+	for first := range masks {
+		for i := 0; i < len(dayBits{})*64; i++ {
+			if weekday := (first + i) % 7; weekday == 0 || weekday == 6 {
+				masks[first].set(i)
+			}
+		}
+	}
+	return masks
+}()
+
+// tableOf 返回 d 所在年份的数据及 d 的年内序号，没有数据时返回 nil
+func (c *Calendar) tableOf(d civilDate) (*yearTable, int) {
+	if len(c.tables) == 0 {
+		return nil, 0
+	}
+	n := d.year - c.tables[0].year
+	if n < 0 || n >= len(c.tables) {
+		return nil, 0
+	}
+	return &c.tables[n], yearDay(d.year, d.month, d.day)
+}
+
+// eachYear 将 [start, end] 按年份切分，对每一段调用 fn
+func (c *Calendar) eachYear(start, end civilDate, fn func(tb *yearTable, from, to int)) {
+	for year := start.year; year <= end.year; year++ {
+		tb, from := c.tableOf(civilDate{year, time.January, 1})
+		if tb == nil {
+			continue
+		}
+		to := daysIn(year) - 1
+		if year == start.year {
+			from = yearDay(start.year, start.month, start.day)
+		}
+		if year == end.year {
+			to = yearDay(end.year, end.month, end.day)
+		}
+		if from <= to {
+			fn(tb, from, to)
+		}
+	}
+}
+
+var daysBeforeMonth = [...]int{0, 31, 59, 90, 120, 151, 181, 212, 243, 273, 304, 334}
+
+func isLeap(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+func daysIn(year int) int {
+	if isLeap(year) {
+		return 366
+	}
+	return 365
+}
+
+// yearDay 返回年内序号，1 月 1 日为 0
+func yearDay(year int, month time.Month, day int) int {
+	n := daysBeforeMonth[month-1] + day - 1
+	if month > time.February && isLeap(year) {
+		n++
+	}
+	return n
+}
+
+// dateOfYearDay 是 yearDay 的逆运算
+func dateOfYearDay(year, i int) civilDate {
+	month := time.December
+	for yearDay(year, month, 1) > i {
+		month--
+	}
+	return civilDate{year, month, i - yearDay(year, month, 1) + 1}
+}
+
+// weekdayOf 计算公历日期是星期几
+func weekdayOf(year int, month time.Month, day int) time.Weekday {
+	offsets := [...]int{0, 3, 2, 5, 0, 3, 5, 1, 4, 6, 2, 4}
+	if month < time.March {
+		year--
+	}
+	return time.Weekday((year + year/4 - year/100 + year/400 + offsets[month-1] + day) % 7)
+}
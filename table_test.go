@@ -0,0 +1,178 @@
+package chinesecalendar
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mapCalendar 以 map 保存节假日的实现，数据来自冻结的 testdata/arrangements.golden，用于对照与基准测试
+type mapCalendar struct {
+	holidays   map[civilDate]Holiday
+	workdays   map[civilDate]Holiday
+	inLieuDays map[civilDate]Holiday
+}
+
+func newMapCalendar(tb testing.TB) *mapCalendar {
+	file, err := os.Open("testdata/arrangements.golden")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer file.Close()
+
+	byEngName := make(map[string]Holiday, len(builtinHolidays))
+	for _, b := range builtinHolidays {
+		byEngName[b.holiday.engName] = b.holiday
+	}
+	mc := &mapCalendar{
+		holidays:   make(map[civilDate]Holiday),
+		workdays:   make(map[civilDate]Holiday),
+		inLieuDays: make(map[civilDate]Holiday),
+	}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, ",")
+		t, err := time.Parse("2006-01-02", fields[0])
+		holiday, ok := byEngName[fields[2]]
+		if err != nil || !ok {
+			tb.Fatalf("invalid line %q", line)
+		}
+		d := civilDateOf(t)
+		if fields[1] == jsonTypeWorkday {
+			mc.workdays[d] = holiday
+		} else {
+			mc.holidays[d] = holiday
+		}
+		if len(fields) > 3 && fields[3] == "inlieu" {
+			mc.inLieuDays[d] = holiday
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		tb.Fatal(err)
+	}
+	return mc
+}
+
+func (mc *mapCalendar) isWorkday(d civilDate) bool {
+	if _, inWorkDay := mc.workdays[d]; inWorkDay {
+		return true
+	}
+	weekday := d.weekday()
+	if _, inHoliday := mc.holidays[d]; !inHoliday && weekday >= 1 && weekday <= 5 {
+		return true
+	}
+	return false
+}
+
+func (mc *mapCalendar) getWorkdays(start, end civilDate) []time.Time {
+	list := make([]time.Time, 0)
+	for d := start; !d.after(end); d = d.addDays(1) {
+		if mc.isWorkday(d) {
+			list = append(list, d.time(ChinaStandardTime))
+		}
+	}
+	return list
+}
+
+func TestYearTables(t *testing.T) {
+	mc := newMapCalendar(t)
+	for d := minDay; !d.after(maxDay); d = d.addDays(1) {
+		assert.Equal(t, mc.isWorkday(d), defaultCalendar.isWorkday(d), d.String())
+		_, inLieu := mc.inLieuDays[d]
+		assert.Equal(t, inLieu, defaultCalendar.isInLieu(d), d.String())
+		holiday, isHoliday := mc.holidays[d]
+		if workday, ok := mc.workdays[d]; ok {
+			holiday = workday
+		}
+		tb, i := defaultCalendar.tableOf(d)
+		tag, _ := tb.holidayAt(i)
+		assert.Equal(t, isHoliday, tb.holidays.has(i), d.String())
+		assert.Equal(t, holiday, tag, d.String())
+		assert.Equal(t, d, dateOfYearDay(d.year, yearDay(d.year, d.month, d.day)))
+		assert.Equal(t, d.time(time.UTC).Weekday(), d.weekday())
+	}
+
+	start, end := civilDate{2015, 12, 20}, civilDate{2017, 1, 10}
+	assert.Equal(t, mc.getWorkdays(start, end), defaultCalendar.getDates(start, end, ChinaStandardTime, (*yearTable).workdayBits))
+}
+
+// TestYear2016 2016 年的安排曾被生成器误写入 2017 年，导致 2016 年没有放假安排、2017 年的安排被覆盖
+func TestYear2016(t *testing.T) {
+	for _, d := range dates(2016, [2]int{1, 1}, [2]int{2, 7}, [2]int{2, 8}, [2]int{2, 12}, [2]int{4, 4}, [2]int{5, 2},
+		[2]int{6, 9}, [2]int{6, 10}, [2]int{9, 15}, [2]int{9, 16}, [2]int{10, 6}, [2]int{10, 7}) {
+		assert.Equal(t, true, IsHoliday(d), d.String())
+		assert.Equal(t, false, IsWorkday(d), d.String())
+	}
+	for _, d := range dates(2016, [2]int{2, 6}, [2]int{2, 14}, [2]int{6, 12}, [2]int{9, 18}, [2]int{10, 8}, [2]int{10, 9}) {
+		assert.Equal(t, true, IsWorkday(d), d.String())
+	}
+	assert.Equal(t, true, IsInLieu(time.Date(2016, 2, 11, 0, 0, 0, 0, ChinaStandardTime)))
+	count, err := CountHolidays(time.Date(2016, 1, 1, 0, 0, 0, 0, ChinaStandardTime), time.Date(2016, 12, 31, 0, 0, 0, 0, ChinaStandardTime), false)
+	assert.NoError(t, err)
+	assert.Equal(t, 24, count)
+
+	// 2017 年的安排不受影响
+	assert.Equal(t, true, IsHoliday(time.Date(2017, 10, 8, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.Equal(t, true, IsWorkday(time.Date(2017, 2, 14, 0, 0, 0, 0, ChinaStandardTime)))
+	assert.Equal(t, true, IsWorkday(time.Date(2017, 6, 12, 0, 0, 0, 0, ChinaStandardTime)))
+}
+
+func TestDayBits(t *testing.T) {
+	var b dayBits
+	for _, i := range []int{0, 1, 63, 64, 130, 365} {
+		b.set(i)
+	}
+	assert.Equal(t, 6, b.count(0, 383))
+	assert.Equal(t, 3, b.count(1, 64))
+	assert.Equal(t, 0, b.count(2, 62))
+	assert.Equal(t, 1, b.count(365, 365))
+
+	var got []int
+	b.each(1, 200, func(i int) { got = append(got, i) })
+	assert.Equal(t, []int{1, 63, 64, 130}, got)
+}
+
+func BenchmarkIsWorkday(b *testing.B) {
+	days := make([]civilDate, 0, 366)
+	for d := (civilDate{2020, 1, 1}); d.year == 2020; d = d.addDays(1) {
+		days = append(days, d)
+	}
+
+	b.Run("bitset", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			defaultCalendar.isWorkday(days[i%len(days)])
+		}
+	})
+	b.Run("map", func(b *testing.B) {
+		mc := newMapCalendar(b)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			mc.isWorkday(days[i%len(days)])
+		}
+	})
+}
+
+func BenchmarkGetWorkdays(b *testing.B) {
+	start, end := civilDate{2010, 1, 1}, civilDate{2020, 12, 31}
+
+	b.Run("bitset", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			defaultCalendar.getDates(start, end, ChinaStandardTime, (*yearTable).workdayBits)
+		}
+	})
+	b.Run("map", func(b *testing.B) {
+		mc := newMapCalendar(b)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			mc.getWorkdays(start, end)
+		}
+	})
+}
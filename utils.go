@@ -2,12 +2,23 @@ package chinesecalendar
 
 import "time"
 
-func validateDate(t time.Time) (time.Time, bool) {
-	t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+// validateDate 取 t 的日期（见 civilDateOf），并检查是否在支持范围内
+func (c *Calendar) validateDate(t time.Time) (civilDate, bool) {
+	d := civilDateOf(t)
 
-	if t.Year() < minDay.Year() || t.Year() > maxDay.Year() {
-		return time.Time{}, false
+	if !c.isSupported(d) {
+		return civilDate{}, false
 	}
 
-	return t, true
+	return d, true
+}
+
+// isSupported 检查日期是否在支持范围（minDay 至 maxDay）内
+func (c *Calendar) isSupported(d civilDate) bool {
+	return !d.before(c.minDay) && !d.after(c.maxDay)
+}
+
+// rangeError 返回 d 超出支持范围的错误，日期以 loc 时区的零点表示
+func (c *Calendar) rangeError(d civilDate, loc *time.Location) error {
+	return &DateRangeError{Date: d.time(loc), Min: c.minDay.time(loc), Max: c.maxDay.time(loc)}
 }
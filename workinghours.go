@@ -0,0 +1,204 @@
+package chinesecalendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WorkingPeriod 一天中的一段工作时间 [Start, End)，Start、End 为距当天零点的时长（墙上时间）
+type WorkingPeriod struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+func (p WorkingPeriod) String() string {
+	format := func(d time.Duration) string {
+		return fmt.Sprintf("%02d:%02d", int(d/time.Hour), int(d%time.Hour/time.Minute))
+	}
+	return format(p.Start) + "-" + format(p.End)
+}
+
+// ParseWorkingPeriods 解析以逗号分隔的工作时间，例如 "09:00-12:00,13:00-18:00"
+func ParseWorkingPeriods(s string) ([]WorkingPeriod, error) {
+	parseClock := func(s string) (time.Duration, error) {
+		var hour, minute int
+		if n, err := fmt.Sscanf(s, "%d:%d", &hour, &minute); err != nil || n != 2 || len(s) != 5 || minute >= 60 {
+			return 0, fmt.Errorf("%w: %q is not in HH:MM format", ErrInvalidWorkingHours, s)
+		}
+		return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute, nil
+	}
+
+	list := make([]WorkingPeriod, 0)
+	for _, item := range strings.Split(s, ",") {
+		parts := strings.Split(strings.TrimSpace(item), "-")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("%w: %q is not in HH:MM-HH:MM format", ErrInvalidWorkingHours, item)
+		}
+		start, err := parseClock(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseClock(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, WorkingPeriod{start, end})
+	}
+	return list, nil
+}
+
+// WorkingHours 工作日内的工作时间，用于按工作时长计算，例如“8 个工作小时内响应”。
+// 是否工作日由 Calendar 决定，调休上班日计为工作日
+type WorkingHours struct {
+	calendar *Calendar
+	loc      *time.Location
+	periods  []WorkingPeriod
+}
+
+// NewWorkingHours 创建工作时间，c 为 nil 时使用 Default()，loc 为工作时间所在的时区，为 nil 时使用 ChinaStandardTime。
+// periods 须按时间先后排列、互不重叠，且在当天的 00:00 至 24:00 之内，否则返回 ErrInvalidWorkingHours
+func NewWorkingHours(c *Calendar, loc *time.Location, periods []WorkingPeriod) (*WorkingHours, error) {
+	if c == nil {
+		c = Default()
+	}
+	if loc == nil {
+		loc = ChinaStandardTime
+	}
+	if len(periods) == 0 {
+		return nil, fmt.Errorf("%w: no working period", ErrInvalidWorkingHours)
+	}
+	var last time.Duration
+	for _, p := range periods {
+		if p.Start < last || p.End <= p.Start || p.End > 24*time.Hour {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidWorkingHours, p)
+		}
+		last = p.End
+	}
+	return &WorkingHours{calendar: c, loc: loc, periods: append([]WorkingPeriod{}, periods...)}, nil
+}
+
+// clock 返回 d 当天距零点 offset 的墙上时间
+func (w *WorkingHours) clock(d civilDate, offset time.Duration) time.Time {
+	return time.Date(d.year, d.month, d.day, int(offset/time.Hour), int(offset%time.Hour/time.Minute),
+		int(offset%time.Minute/time.Second), int(offset%time.Second), w.loc)
+}
+
+// isWorkday 检查 d 是否工作日，超出日历的支持范围时返回 *DateRangeError
+func (w *WorkingHours) isWorkday(d civilDate) (bool, error) {
+	if !w.calendar.isSupported(d) {
+		return false, w.calendar.rangeError(d, w.loc)
+	}
+	return w.calendar.isWorkday(d), nil
+}
+
+// Contains 检查 t 是否在工作时间内，t 按 WorkingHours 的时区判断日期与时刻
+func (w *WorkingHours) Contains(t time.Time) (bool, error) {
+	t = t.In(w.loc)
+	d := dateOf(t)
+	isWorkday, err := w.isWorkday(d)
+	if err != nil || !isWorkday {
+		return false, err
+	}
+	for _, p := range w.periods {
+		if !t.Before(w.clock(d, p.Start)) && t.Before(w.clock(d, p.End)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Add 返回从 t 开始经过 duration 的工作时长后的时刻，duration 为负数时向前计算。
+// t 不在工作时间内时从下一段（向前计算时为上一段）工作时间开始计算，
+// 恰好在一段工作时间结束时用完的，返回该段的结束时刻。
+// 计算过程中经过的日期超出日历的支持范围时返回 *DateRangeError
+func (w *WorkingHours) Add(t time.Time, duration time.Duration) (time.Time, error) {
+	t = t.In(w.loc)
+	if duration == 0 {
+		return t, nil
+	}
+
+	d := dateOf(t)
+	for {
+		isWorkday, err := w.isWorkday(d)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if isWorkday && duration > 0 {
+			for _, p := range w.periods {
+				start, end := w.clock(d, p.Start), w.clock(d, p.End)
+				if !end.After(t) {
+					continue
+				}
+				if t.Before(start) {
+					t = start
+				}
+				available := end.Sub(t)
+				if duration <= available {
+					return t.Add(duration), nil
+				}
+				duration -= available
+				t = end
+			}
+		}
+		if isWorkday && duration < 0 {
+			for i := len(w.periods) - 1; i >= 0; i-- {
+				start, end := w.clock(d, w.periods[i].Start), w.clock(d, w.periods[i].End)
+				if !start.Before(t) {
+					continue
+				}
+				if t.After(end) {
+					t = end
+				}
+				available := t.Sub(start)
+				if -duration <= available {
+					return t.Add(duration), nil
+				}
+				duration += available
+				t = start
+			}
+		}
+
+		if duration > 0 {
+			d = d.addDays(1)
+			t = w.clock(d, 0)
+		} else {
+			d = d.addDays(-1)
+			t = w.clock(d, 24*time.Hour)
+		}
+	}
+}
+
+// Between 返回 [start, end) 内的工作时长，end 早于 start 时返回负数。
+// start、end 所在的日期超出日历的支持范围时返回 *DateRangeError
+func (w *WorkingHours) Between(start, end time.Time) (time.Duration, error) {
+	if end.Before(start) {
+		duration, err := w.Between(end, start)
+		return -duration, err
+	}
+
+	start, end = start.In(w.loc), end.In(w.loc)
+	var total time.Duration
+	for d := dateOf(start); !d.after(dateOf(end)); d = d.addDays(1) {
+		isWorkday, err := w.isWorkday(d)
+		if err != nil {
+			return 0, err
+		}
+		if !isWorkday {
+			continue
+		}
+		for _, p := range w.periods {
+			from, to := w.clock(d, p.Start), w.clock(d, p.End)
+			if from.Before(start) {
+				from = start
+			}
+			if to.After(end) {
+				to = end
+			}
+			if to.After(from) {
+				total += to.Sub(from)
+			}
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,134 @@
+package chinesecalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestWorkingHours(t *testing.T) *WorkingHours {
+	periods, err := ParseWorkingPeriods("09:00-12:00, 13:00-18:00")
+	assert.NoError(t, err)
+	w, err := NewWorkingHours(Default(), nil, periods)
+	assert.NoError(t, err)
+	return w
+}
+
+func cst(month time.Month, day, hour, minute int) time.Time {
+	return time.Date(2024, month, day, hour, minute, 0, 0, ChinaStandardTime)
+}
+
+func TestParseWorkingPeriods(t *testing.T) {
+	periods, err := ParseWorkingPeriods("09:00-12:00,13:30-18:00")
+	assert.NoError(t, err)
+	assert.Equal(t, []WorkingPeriod{{9 * time.Hour, 12 * time.Hour}, {13*time.Hour + 30*time.Minute, 18 * time.Hour}}, periods)
+	assert.Equal(t, "13:30-18:00", periods[1].String())
+
+	for _, s := range []string{"", "9:00-12:00", "09:00", "09:00-12:60", "09:00~12:00"} {
+		_, err := ParseWorkingPeriods(s)
+		assert.ErrorIs(t, err, ErrInvalidWorkingHours, s)
+	}
+	for _, s := range []string{"13:00-18:00,09:00-12:00", "09:00-12:00,11:00-18:00", "12:00-09:00", "20:00-25:00"} {
+		periods, err := ParseWorkingPeriods(s)
+		assert.NoError(t, err)
+		_, err = NewWorkingHours(Default(), nil, periods)
+		assert.ErrorIs(t, err, ErrInvalidWorkingHours, s)
+	}
+}
+
+func TestWorkingHoursContains(t *testing.T) {
+	w := newTestWorkingHours(t)
+	args := []struct {
+		t      time.Time
+		expect bool
+	}{
+		{cst(9, 30, 9, 0), true},
+		{cst(9, 30, 12, 0), false},
+		{cst(9, 30, 12, 30), false},
+		{cst(9, 30, 17, 59), true},
+		{cst(10, 1, 10, 0), false}, // 国庆节
+		{cst(10, 12, 10, 0), true}, // 调休上班
+		// 按 WorkingHours 的时区判断：UTC 9 月 30 日 23:00 为北京时间 10 月 1 日
+		{time.Date(2024, 9, 30, 1, 0, 0, 0, time.UTC), true},
+		{time.Date(2024, 9, 30, 23, 0, 0, 0, time.UTC), false},
+	}
+	for _, arg := range args {
+		ok, err := w.Contains(arg.t)
+		assert.NoError(t, err)
+		assert.Equal(t, arg.expect, ok, arg.t)
+	}
+
+	_, err := w.Contains(cst(10, 13, 10, 0))
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+
+	// 没有指定日历时使用内置数据
+	periods, err := ParseWorkingPeriods("09:00-18:00")
+	assert.NoError(t, err)
+	w, err = NewWorkingHours(nil, nil, periods)
+	assert.NoError(t, err)
+	ok, err := w.Contains(cst(10, 12, 10, 0))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestWorkingHoursAdd(t *testing.T) {
+	w := newTestWorkingHours(t)
+	args := []struct {
+		t        time.Time
+		duration time.Duration
+		expect   time.Time
+	}{
+		{cst(9, 30, 10, 0), 0, cst(9, 30, 10, 0)},
+		{cst(9, 30, 10, 0), 2 * time.Hour, cst(9, 30, 12, 0)},
+		{cst(9, 30, 10, 0), 3 * time.Hour, cst(9, 30, 14, 0)},
+		{cst(9, 30, 12, 30), time.Hour, cst(9, 30, 14, 0)},
+		{cst(9, 30, 7, 0), 8 * time.Hour, cst(9, 30, 18, 0)},
+		// 跨国庆假期，10 月 8 日上班
+		{cst(9, 30, 16, 0), 8 * time.Hour, cst(10, 8, 16, 0)},
+		{cst(9, 29, 10, 0), 8 * time.Hour, cst(9, 30, 10, 0)}, // 9 月 29 日为调休上班日
+		{cst(10, 8, 10, 0), -2 * time.Hour, cst(9, 30, 17, 0)},
+		{cst(10, 8, 13, 30), -time.Hour, cst(10, 8, 11, 30)},
+		{cst(9, 30, 20, 0), -30 * time.Minute, cst(9, 30, 17, 30)},
+	}
+	for _, arg := range args {
+		got, err := w.Add(arg.t, arg.duration)
+		assert.NoError(t, err)
+		assert.Equal(t, arg.expect, got, arg.t)
+	}
+
+	_, err := w.Add(cst(10, 12, 10, 0), 8*time.Hour)
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+}
+
+func TestWorkingHoursBetween(t *testing.T) {
+	w := newTestWorkingHours(t)
+	args := []struct {
+		start, end time.Time
+		expect     time.Duration
+	}{
+		{cst(9, 30, 10, 0), cst(9, 30, 10, 0), 0},
+		{cst(9, 30, 10, 0), cst(9, 30, 14, 0), 3 * time.Hour},
+		{cst(9, 30, 0, 0), cst(10, 1, 0, 0), 8 * time.Hour},
+		{cst(9, 30, 16, 0), cst(10, 8, 16, 0), 8 * time.Hour},
+		{cst(10, 8, 16, 0), cst(9, 30, 16, 0), -8 * time.Hour},
+		{cst(10, 1, 0, 0), cst(10, 8, 0, 0), 0},
+	}
+	for _, arg := range args {
+		got, err := w.Between(arg.start, arg.end)
+		assert.NoError(t, err)
+		assert.Equal(t, arg.expect, got, arg.start)
+	}
+
+	// 与 Add 互为逆运算
+	for _, d := range []time.Duration{time.Minute, 5 * time.Hour, 50 * time.Hour} {
+		end, err := w.Add(cst(2, 8, 11, 15), d)
+		assert.NoError(t, err)
+		got, err := w.Between(cst(2, 8, 11, 15), end)
+		assert.NoError(t, err)
+		assert.Equal(t, d, got)
+	}
+
+	_, err := w.Between(cst(10, 8, 0, 0), cst(12, 1, 0, 0))
+	assert.ErrorIs(t, err, ErrUnSupportDate)
+}